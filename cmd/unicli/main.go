@@ -17,25 +17,41 @@ limitations under the License.
 package main
 
 import (
+	"context"
 	"fmt"
 	"os"
+	"os/signal"
+	"syscall"
 
 	"github.com/spf13/cobra"
 
+	"github.com/nscaledev/unicli/pkg/auth"
+	"github.com/nscaledev/unicli/pkg/config"
 	"github.com/nscaledev/unicli/pkg/connect"
 	"github.com/nscaledev/unicli/pkg/create"
+	"github.com/nscaledev/unicli/pkg/delete"
 	"github.com/nscaledev/unicli/pkg/describe"
+	"github.com/nscaledev/unicli/pkg/edit"
+	"github.com/nscaledev/unicli/pkg/errors"
 	"github.com/nscaledev/unicli/pkg/factory"
 	"github.com/nscaledev/unicli/pkg/get"
+	"github.com/nscaledev/unicli/pkg/report"
+	"github.com/nscaledev/unicli/pkg/scale"
+	"github.com/nscaledev/unicli/pkg/securitygroup"
+	"github.com/nscaledev/unicli/pkg/wait"
 )
 
 func main() {
+	factory := factory.NewFactory()
+
 	cmd := &cobra.Command{
 		Use:   "unicli",
 		Short: "Unified Nscale Infrastructure CLI",
+		PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+			return factory.ApplyProfile(cmd)
+		},
 	}
 
-	factory := factory.NewFactory()
 	factory.AddFlags(cmd.PersistentFlags())
 
 	if err := factory.RegisterCompletionFunctions(cmd); err != nil {
@@ -45,13 +61,29 @@ func main() {
 
 	cmd.AddCommand(
 		create.Command(factory),
+		delete.Command(factory),
 		describe.Command(factory),
+		edit.Command(factory),
 		get.Command(factory),
 		connect.Command(factory),
+		config.Command(factory),
+		wait.Command(factory),
+		auth.Command(factory),
+		report.Command(factory),
+		securitygroup.Command(factory),
+		scale.Command(factory),
 	)
 
-	if err := cmd.Execute(); err != nil {
+	// Interrupting the command cancels any API calls in flight, and any
+	// background processing e.g. caches, so we exit cleanly.
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+
+	err := cmd.ExecuteContext(ctx)
+
+	cancel()
+
+	if err != nil {
 		fmt.Println(err)
-		os.Exit(1)
+		os.Exit(errors.ExitCode(err))
 	}
 }
@@ -0,0 +1,470 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package permissions
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/nscaledev/unicli/pkg/factory"
+	"github.com/nscaledev/unicli/pkg/flags"
+	"github.com/nscaledev/unicli/pkg/printer"
+	"github.com/nscaledev/unicli/pkg/util"
+	"github.com/unikorn-cloud/core/pkg/constants"
+	identityv1 "github.com/unikorn-cloud/identity/pkg/apis/unikorn/v1alpha1"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// operations is the canonical order of operations, for display.
+var operations = []identityv1.Operation{
+	identityv1.Create,
+	identityv1.Read,
+	identityv1.Update,
+	identityv1.Delete,
+}
+
+// permissions is the set of operations allowed on each scope.
+type permissions map[string][]identityv1.Operation
+
+// add merges in the operations allowed by the scopes.
+func (p permissions) add(scopes []identityv1.RoleScope) {
+	for _, scope := range scopes {
+		merged := append(p[scope.Name], scope.Operations...)
+
+		slices.SortFunc(merged, func(a, b identityv1.Operation) int {
+			return slices.Index(operations, a) - slices.Index(operations, b)
+		})
+
+		p[scope.Name] = slices.Compact(merged)
+	}
+}
+
+// strings returns each scope and its allowed operations e.g. "identity:projects(create,read)".
+func (p permissions) strings() []string {
+	result := make([]string, 0, len(p))
+
+	for _, name := range slices.Sorted(maps.Keys(p)) {
+		ops := make([]string, len(p[name]))
+
+		for i, op := range p[name] {
+			ops[i] = string(op)
+		}
+
+		result = append(result, fmt.Sprintf("%s(%s)", name, strings.Join(ops, ",")))
+	}
+
+	return result
+}
+
+// grant is the access a user has globally, across an organization, or in a
+// single project.
+type grant struct {
+	organization *printer.Reference
+	project      *printer.Reference
+	groups       []string
+	roles        []string
+	permissions  permissions
+}
+
+func newGrant(organization, project *printer.Reference) *grant {
+	return &grant{
+		organization: organization,
+		project:      project,
+		permissions:  permissions{},
+	}
+}
+
+// add records the access granted by a group's roles, the scopes callback
+// selects which of the role's scopes apply to the grant.  Only groups and roles
+// that contribute permissions are recorded.
+func (g *grant) add(group *identityv1.Group, roles map[string]*identityv1.Role, scopes func(*identityv1.RoleScopes) []identityv1.RoleScope) {
+	var contributes bool
+
+	for _, id := range group.Spec.RoleIDs {
+		role, ok := roles[id]
+		if !ok {
+			continue
+		}
+
+		granted := scopes(&role.Spec.Scopes)
+		if len(granted) == 0 {
+			continue
+		}
+
+		contributes = true
+
+		if name := role.Labels[constants.NameLabel]; !slices.Contains(g.roles, name) {
+			g.roles = append(g.roles, name)
+		}
+
+		g.permissions.add(granted)
+	}
+
+	if contributes {
+		g.groups = append(g.groups, group.Labels[constants.NameLabel])
+	}
+
+	slices.Sort(g.roles)
+}
+
+func referenceObject(reference *printer.Reference) any {
+	if reference == nil {
+		return nil
+	}
+
+	return map[string]any{
+		"id":   reference.ID,
+		"name": reference.Name,
+	}
+}
+
+func (g *grant) object() unstructured.Unstructured {
+	permissions := make([]any, 0, len(g.permissions))
+
+	for _, name := range slices.Sorted(maps.Keys(g.permissions)) {
+		ops := make([]any, len(g.permissions[name]))
+
+		for i, op := range g.permissions[name] {
+			ops[i] = string(op)
+		}
+
+		permissions = append(permissions, map[string]any{
+			"name":       name,
+			"operations": ops,
+		})
+	}
+
+	toAny := func(in []string) []any {
+		out := make([]any, len(in))
+
+		for i := range in {
+			out[i] = in[i]
+		}
+
+		return out
+	}
+
+	object := map[string]any{
+		"kind":        "Permissions",
+		"groups":      toAny(g.groups),
+		"roles":       toAny(g.roles),
+		"permissions": permissions,
+	}
+
+	if g.organization != nil {
+		object["organization"] = referenceObject(g.organization)
+	}
+
+	if g.project != nil {
+		object["project"] = referenceObject(g.project)
+	}
+
+	return unstructured.Unstructured{Object: object}
+}
+
+func (g *grant) row() []string {
+	organization := "*"
+	if g.organization != nil {
+		organization = g.organization.Name
+	}
+
+	project := "*"
+	if g.project != nil {
+		project = g.project.Name
+	}
+
+	return []string{
+		organization,
+		project,
+		strings.Join(g.groups, ", "),
+		strings.Join(g.roles, ", "),
+		strings.Join(g.permissions.strings(), "\n"),
+	}
+}
+
+type options struct {
+	UnikornFlags *factory.UnikornFlags
+	PrintFlags   *printer.Flags
+
+	organization *flags.OrganizationFlags
+	email        string
+
+	user *identityv1.User
+}
+
+func (o *options) AddFlags(cmd *cobra.Command, factory *factory.Factory) error {
+	cmd.Flags().StringVar(&o.email, "user", "", "User's email address.")
+
+	if err := cmd.MarkFlagRequired("user"); err != nil {
+		return err
+	}
+
+	if err := cmd.RegisterFlagCompletionFunc("user", factory.UserSubjectCompletionFunc()); err != nil {
+		return err
+	}
+
+	if err := o.organization.AddFlags(cmd, factory, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (o *options) validateUser(ctx context.Context, cli client.Client) error {
+	user, err := util.GetUser(ctx, cli, o.UnikornFlags.IdentityNamespace, o.email)
+	if err != nil {
+		return err
+	}
+
+	o.user = user
+
+	return nil
+}
+
+func (o *options) validate(ctx context.Context, cli client.Client) error {
+	validators := []func(context.Context, client.Client) error{
+		o.organization.Validate,
+		o.validateUser,
+	}
+
+	for _, validator := range validators {
+		if err := validator(ctx, cli); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func Command(factory *factory.Factory) *cobra.Command {
+	unikornFlags := &factory.UnikornFlags
+
+	o := options{
+		UnikornFlags: unikornFlags,
+		PrintFlags:   &factory.PrintFlags,
+		organization: flags.NewOrganizationFlags(unikornFlags),
+	}
+
+	cmd := &cobra.Command{
+		Use:   "permissions",
+		Short: "Show a user's effective permissions",
+		Long: `Show a user's effective permissions.
+
+Access is granted to organization users by the roles of the groups they are
+members of.  Global scopes apply everywhere, organization scopes across the
+whole organization, and project scopes only to projects the group is bound
+to.  Global access is shown with an organization of "*", and organization
+wide access with a project of "*".
+
+Examples:
+  # Show everything a user can do
+  unicli auth permissions --user jo@acme.com
+
+  # Limit the report to a single organization
+  unicli auth permissions --user jo@acme.com --organization acme`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			client, err := factory.Client(ctx)
+			if err != nil {
+				return err
+			}
+
+			if err := o.validate(ctx, client); err != nil {
+				return err
+			}
+
+			if err := o.execute(ctx, client); err != nil {
+				return err
+			}
+
+			return nil
+		},
+	}
+
+	if err := o.AddFlags(cmd, factory); err != nil {
+		panic(err)
+	}
+
+	return cmd
+}
+
+// organizationGrants returns the access a user has via their membership of an
+// organization, organization wide access first, then each project.
+func (o *options) organizationGrants(ctx context.Context, cli client.Client, organization *identityv1.Organization, organizationUser *identityv1.OrganizationUser, roles map[string]*identityv1.Role, global *grant) ([]*grant, error) {
+	groups := &identityv1.GroupList{}
+	if err := cli.List(ctx, groups, &client.ListOptions{Namespace: organizationUser.Namespace}); err != nil {
+		return nil, fmt.Errorf("failed to list groups: %w", err)
+	}
+
+	projects := &identityv1.ProjectList{}
+	if err := cli.List(ctx, projects, &client.ListOptions{Namespace: organizationUser.Namespace}); err != nil {
+		return nil, fmt.Errorf("failed to list projects: %w", err)
+	}
+
+	organizationReference := &printer.Reference{
+		ID:   organization.Name,
+		Name: organization.Labels[constants.NameLabel],
+	}
+
+	organizationGrant := newGrant(organizationReference, nil)
+
+	var memberGroups []*identityv1.Group
+
+	for i := range groups.Items {
+		group := &groups.Items[i]
+
+		if !util.IsGroupMember(group, organizationUser.Name, o.email) {
+			continue
+		}
+
+		memberGroups = append(memberGroups, group)
+
+		organizationGrant.add(group, roles, func(scopes *identityv1.RoleScopes) []identityv1.RoleScope {
+			return scopes.Organization
+		})
+
+		global.add(group, roles, func(scopes *identityv1.RoleScopes) []identityv1.RoleScope {
+			return scopes.Global
+		})
+	}
+
+	grants := []*grant{organizationGrant}
+
+	slices.SortFunc(projects.Items, func(a, b identityv1.Project) int {
+		return strings.Compare(a.Labels[constants.NameLabel], b.Labels[constants.NameLabel])
+	})
+
+	for i := range projects.Items {
+		project := &projects.Items[i]
+
+		projectReference := &printer.Reference{
+			ID:   project.Name,
+			Name: project.Labels[constants.NameLabel],
+		}
+
+		projectGrant := newGrant(organizationReference, projectReference)
+
+		for _, group := range memberGroups {
+			if !slices.Contains(project.Spec.GroupIDs, group.Name) {
+				continue
+			}
+
+			projectGrant.add(group, roles, func(scopes *identityv1.RoleScopes) []identityv1.RoleScope {
+				return scopes.Project
+			})
+		}
+
+		if len(projectGrant.groups) != 0 {
+			grants = append(grants, projectGrant)
+		}
+	}
+
+	return grants, nil
+}
+
+func (o *options) execute(ctx context.Context, cli client.Client) error {
+	roleList := &identityv1.RoleList{}
+	if err := cli.List(ctx, roleList, &client.ListOptions{Namespace: o.UnikornFlags.IdentityNamespace}); err != nil {
+		return fmt.Errorf("failed to list roles: %w", err)
+	}
+
+	roles := make(map[string]*identityv1.Role, len(roleList.Items))
+
+	for i := range roleList.Items {
+		roles[roleList.Items[i].Name] = &roleList.Items[i]
+	}
+
+	organizations := &identityv1.OrganizationList{}
+	if err := cli.List(ctx, organizations, &client.ListOptions{Namespace: o.UnikornFlags.IdentityNamespace}); err != nil {
+		return fmt.Errorf("failed to list organizations: %w", err)
+	}
+
+	options := &client.ListOptions{
+		LabelSelector: labels.SelectorFromSet(labels.Set{
+			constants.UserLabel: o.user.Name,
+		}),
+	}
+
+	if o.organization.Organization != nil {
+		options.Namespace = o.organization.Organization.Status.Namespace
+	}
+
+	organizationUsers := &identityv1.OrganizationUserList{}
+	if err := cli.List(ctx, organizationUsers, options); err != nil {
+		return fmt.Errorf("failed to list organization users: %w", err)
+	}
+
+	global := newGrant(nil, nil)
+
+	var grants []*grant
+
+	for i := range organizations.Items {
+		organization := &organizations.Items[i]
+
+		index := slices.IndexFunc(organizationUsers.Items, func(ou identityv1.OrganizationUser) bool {
+			return ou.Labels[constants.OrganizationLabel] == organization.Name
+		})
+
+		if index < 0 {
+			continue
+		}
+
+		organizationGrants, err := o.organizationGrants(ctx, cli, organization, &organizationUsers.Items[index], roles, global)
+		if err != nil {
+			return err
+		}
+
+		grants = append(grants, organizationGrants...)
+	}
+
+	slices.SortStableFunc(grants, func(a, b *grant) int {
+		return strings.Compare(a.organization.Name, b.organization.Name)
+	})
+
+	if len(global.permissions) != 0 {
+		grants = append([]*grant{global}, grants...)
+	}
+
+	if o.PrintFlags.Structured() {
+		objects := make([]unstructured.Unstructured, len(grants))
+
+		for i := range grants {
+			objects[i] = grants[i].object()
+		}
+
+		return o.PrintFlags.PrintListing(os.Stdout, &printer.Listing{Objects: objects})
+	}
+
+	t := printer.NewTable("Organization", "Project", "Groups", "Roles", "Permissions")
+
+	for _, g := range grants {
+		t.Row(g.row()...)
+	}
+
+	return o.PrintFlags.PrintListing(os.Stdout, &printer.Listing{Table: t})
+}
@@ -0,0 +1,278 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package whocan
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/nscaledev/unicli/pkg/factory"
+	"github.com/nscaledev/unicli/pkg/flags"
+	"github.com/nscaledev/unicli/pkg/printer"
+	"github.com/nscaledev/unicli/pkg/resolve"
+	"github.com/nscaledev/unicli/pkg/util"
+	"github.com/unikorn-cloud/core/pkg/constants"
+	identityv1 "github.com/unikorn-cloud/identity/pkg/apis/unikorn/v1alpha1"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+type options struct {
+	UnikornFlags *factory.UnikornFlags
+	PrintFlags   *printer.Flags
+
+	organization *flags.OrganizationFlags
+	roleName     string
+
+	role *identityv1.Role
+}
+
+func (o *options) AddFlags(cmd *cobra.Command, factory *factory.Factory) error {
+	cmd.Flags().StringVar(&o.roleName, "role", "", "Role name or ID.")
+
+	if err := cmd.MarkFlagRequired("role"); err != nil {
+		return err
+	}
+
+	if err := cmd.RegisterFlagCompletionFunc("role", factory.RoleNameCompletionFunc()); err != nil {
+		return err
+	}
+
+	if err := o.organization.AddFlags(cmd, factory, true); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (o *options) validateRole(ctx context.Context, cli client.Client) error {
+	scope := &resolve.Scope{
+		IdentityNamespace: o.UnikornFlags.IdentityNamespace,
+		Namespace:         o.UnikornFlags.IdentityNamespace,
+	}
+
+	role, err := resolve.Role(ctx, cli, scope, o.roleName)
+	if err != nil {
+		return err
+	}
+
+	o.role = role
+
+	return nil
+}
+
+func (o *options) validate(ctx context.Context, cli client.Client) error {
+	validators := []func(context.Context, client.Client) error{
+		o.organization.Validate,
+		o.validateRole,
+	}
+
+	for _, validator := range validators {
+		if err := validator(ctx, cli); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func Command(factory *factory.Factory) *cobra.Command {
+	unikornFlags := &factory.UnikornFlags
+
+	o := options{
+		UnikornFlags: unikornFlags,
+		PrintFlags:   &factory.PrintFlags,
+		organization: flags.NewOrganizationFlags(unikornFlags),
+	}
+
+	cmd := &cobra.Command{
+		Use:   "who-can",
+		Short: "List users granted a role in an organization",
+		Long: `List users granted a role in an organization.
+
+Users are granted roles by membership of groups, the groups that grant the
+role, and the projects those groups are bound to, are shown for each user.
+
+Examples:
+  # List everyone with administrative access to an organization
+  unicli auth who-can --role administrator --organization acme`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			client, err := factory.Client(ctx)
+			if err != nil {
+				return err
+			}
+
+			if err := o.validate(ctx, client); err != nil {
+				return err
+			}
+
+			if err := o.execute(ctx, client); err != nil {
+				return err
+			}
+
+			return nil
+		},
+	}
+
+	if err := o.AddFlags(cmd, factory); err != nil {
+		panic(err)
+	}
+
+	return cmd
+}
+
+// subject is a user granted the role, and how.
+type subject struct {
+	email    string
+	groups   []string
+	projects []string
+}
+
+func (s *subject) object() unstructured.Unstructured {
+	toAny := func(in []string) []any {
+		out := make([]any, len(in))
+
+		for i := range in {
+			out[i] = in[i]
+		}
+
+		return out
+	}
+
+	return unstructured.Unstructured{
+		Object: map[string]any{
+			"kind":     "Subject",
+			"email":    s.email,
+			"groups":   toAny(s.groups),
+			"projects": toAny(s.projects),
+		},
+	}
+}
+
+func (o *options) execute(ctx context.Context, cli client.Client) error {
+	namespace := o.organization.Organization.Status.Namespace
+
+	groups := &identityv1.GroupList{}
+	if err := cli.List(ctx, groups, &client.ListOptions{Namespace: namespace}); err != nil {
+		return fmt.Errorf("failed to list groups: %w", err)
+	}
+
+	projects := &identityv1.ProjectList{}
+	if err := cli.List(ctx, projects, &client.ListOptions{Namespace: namespace}); err != nil {
+		return fmt.Errorf("failed to list projects: %w", err)
+	}
+
+	userEmails, err := util.CreateOrganizationUserMap(ctx, cli, o.UnikornFlags.IdentityNamespace, namespace)
+	if err != nil {
+		return fmt.Errorf("failed to list users: %w", err)
+	}
+
+	subjects := map[string]*subject{}
+
+	addSubject := func(email string, group *identityv1.Group, bound []string) {
+		s, ok := subjects[email]
+		if !ok {
+			s = &subject{
+				email: email,
+			}
+
+			subjects[email] = s
+		}
+
+		if name := group.Labels[constants.NameLabel]; !slices.Contains(s.groups, name) {
+			s.groups = append(s.groups, name)
+		}
+
+		for _, project := range bound {
+			if !slices.Contains(s.projects, project) {
+				s.projects = append(s.projects, project)
+			}
+		}
+	}
+
+	for i := range groups.Items {
+		group := &groups.Items[i]
+
+		if !slices.Contains(group.Spec.RoleIDs, o.role.Name) {
+			continue
+		}
+
+		var bound []string
+
+		for j := range projects.Items {
+			if slices.Contains(projects.Items[j].Spec.GroupIDs, group.Name) {
+				bound = append(bound, projects.Items[j].Labels[constants.NameLabel])
+			}
+		}
+
+		for _, id := range group.Spec.UserIDs {
+			addSubject(printer.NewReference(userEmails, id).Name, group, bound)
+		}
+
+		for _, s := range group.Spec.Subjects {
+			// Like group membership, only subjects without an issuer identify
+			// users, see util.IsGroupMember.
+			if s.Issuer != "" {
+				continue
+			}
+
+			email := s.Email
+			if email == "" {
+				email = s.ID
+			}
+
+			addSubject(email, group, bound)
+		}
+	}
+
+	emails := slices.Sorted(maps.Keys(subjects))
+
+	for _, s := range subjects {
+		slices.Sort(s.groups)
+		slices.Sort(s.projects)
+	}
+
+	if o.PrintFlags.Structured() {
+		objects := make([]unstructured.Unstructured, len(emails))
+
+		for i, email := range emails {
+			objects[i] = subjects[email].object()
+		}
+
+		return o.PrintFlags.PrintListing(os.Stdout, &printer.Listing{Objects: objects})
+	}
+
+	t := printer.NewTable("User", "Groups", "Projects")
+
+	for _, email := range emails {
+		s := subjects[email]
+
+		t.Row(s.email, strings.Join(s.groups, ", "), strings.Join(s.projects, ", "))
+	}
+
+	return o.PrintFlags.PrintListing(os.Stdout, &printer.Listing{Table: t})
+}
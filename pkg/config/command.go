@@ -0,0 +1,43 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/nscaledev/unicli/pkg/config/setorganization"
+	"github.com/nscaledev/unicli/pkg/config/setproject"
+	"github.com/nscaledev/unicli/pkg/config/useprofile"
+	"github.com/nscaledev/unicli/pkg/config/view"
+	"github.com/nscaledev/unicli/pkg/factory"
+)
+
+func Command(factory *factory.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "config",
+		Short: "Manage CLI configuration profiles",
+	}
+
+	cmd.AddCommand(
+		setorganization.Command(factory),
+		setproject.Command(factory),
+		useprofile.Command(factory),
+		view.Command(factory),
+	)
+
+	return cmd
+}
@@ -0,0 +1,98 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package setorganization
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/nscaledev/unicli/pkg/factory"
+	"github.com/nscaledev/unicli/pkg/profile"
+	"github.com/nscaledev/unicli/pkg/resolve"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+type options struct {
+	UnikornFlags *factory.UnikornFlags
+}
+
+func (o *options) execute(ctx context.Context, cli client.Client, name string) error {
+	if _, err := resolve.Organization(ctx, cli, &resolve.Scope{IdentityNamespace: o.UnikornFlags.IdentityNamespace}, name); err != nil {
+		return err
+	}
+
+	config, err := profile.Load()
+	if err != nil {
+		return err
+	}
+
+	profileName := config.ActiveName(o.UnikornFlags.Profile)
+
+	p := config.Get(profileName)
+
+	// Projects are scoped to an organization, so any existing one is
+	// no longer valid.
+	if p.Organization != name {
+		p.Project = ""
+	}
+
+	p.Organization = name
+
+	if config.CurrentProfile == "" {
+		config.CurrentProfile = profileName
+	}
+
+	if err := config.Save(); err != nil {
+		return err
+	}
+
+	fmt.Printf("organization set to %s in profile %s\n", name, profileName)
+
+	return nil
+}
+
+func Command(factory *factory.Factory) *cobra.Command {
+	o := options{
+		UnikornFlags: &factory.UnikornFlags,
+	}
+
+	cmd := &cobra.Command{
+		Use:               "set-organization <name>",
+		Short:             "Set the default organization for the current profile",
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: factory.OrganizationNameCompletionFunc(),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			client, err := factory.Client(ctx)
+			if err != nil {
+				return err
+			}
+
+			if err := o.execute(ctx, client, args[0]); err != nil {
+				return err
+			}
+
+			return nil
+		},
+	}
+
+	return cmd
+}
@@ -0,0 +1,129 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package setproject
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/nscaledev/unicli/pkg/factory"
+	"github.com/nscaledev/unicli/pkg/flags"
+	"github.com/nscaledev/unicli/pkg/profile"
+	"github.com/nscaledev/unicli/pkg/resolve"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+type options struct {
+	UnikornFlags *factory.UnikornFlags
+
+	organization *flags.OrganizationFlags
+}
+
+func (o *options) AddFlags(cmd *cobra.Command, factory *factory.Factory) error {
+	if err := o.organization.AddFlags(cmd, factory, true); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (o *options) validate(ctx context.Context, cli client.Client) error {
+	validators := []func(context.Context, client.Client) error{
+		o.organization.Validate,
+	}
+
+	for _, validator := range validators {
+		if err := validator(ctx, cli); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (o *options) execute(ctx context.Context, cli client.Client, name string) error {
+	if _, err := resolve.Project(ctx, cli, flags.Scope(o.UnikornFlags, o.organization, nil), name); err != nil {
+		return err
+	}
+
+	config, err := profile.Load()
+	if err != nil {
+		return err
+	}
+
+	profileName := config.ActiveName(o.UnikornFlags.Profile)
+
+	p := config.Get(profileName)
+	p.Organization = o.organization.OrganizationName
+	p.Project = name
+
+	if config.CurrentProfile == "" {
+		config.CurrentProfile = profileName
+	}
+
+	if err := config.Save(); err != nil {
+		return err
+	}
+
+	fmt.Printf("project set to %s in profile %s\n", name, profileName)
+
+	return nil
+}
+
+func Command(factory *factory.Factory) *cobra.Command {
+	unikornFlags := &factory.UnikornFlags
+	organizationFlags := flags.NewOrganizationFlags(unikornFlags)
+
+	o := options{
+		UnikornFlags: unikornFlags,
+		organization: organizationFlags,
+	}
+
+	cmd := &cobra.Command{
+		Use:               "set-project <name>",
+		Short:             "Set the default project for the current profile",
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: factory.ProjectNameCompletionFunc(&organizationFlags.OrganizationName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			client, err := factory.Client(ctx)
+			if err != nil {
+				return err
+			}
+
+			if err := o.validate(ctx, client); err != nil {
+				return err
+			}
+
+			if err := o.execute(ctx, client, args[0]); err != nil {
+				return err
+			}
+
+			return nil
+		},
+	}
+
+	if err := o.AddFlags(cmd, factory); err != nil {
+		panic(err)
+	}
+
+	return cmd
+}
@@ -0,0 +1,106 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package useprofile
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/nscaledev/unicli/pkg/factory"
+	"github.com/nscaledev/unicli/pkg/profile"
+)
+
+type options struct {
+	UnikornFlags *factory.UnikornFlags
+}
+
+func (o *options) execute(cmd *cobra.Command, name string) error {
+	config, err := profile.Load()
+	if err != nil {
+		return err
+	}
+
+	p := config.Get(name)
+
+	// Record any global flags given explicitly, this is how the profile's
+	// kubeconfig, context and service namespaces are set.
+	values := map[string]*string{
+		"kubeconfig":         &p.Kubeconfig,
+		"context":            &p.Context,
+		"identity-namespace": &p.IdentityNamespace,
+		"region-namespace":   &p.RegionNamespace,
+		"compute-namespace":  &p.ComputeNamespace,
+	}
+
+	for flag, value := range values {
+		if !cmd.Flags().Changed(flag) {
+			continue
+		}
+
+		if *value, err = cmd.Flags().GetString(flag); err != nil {
+			return err
+		}
+	}
+
+	config.CurrentProfile = name
+
+	if err := config.Save(); err != nil {
+		return err
+	}
+
+	fmt.Printf("switched to profile %s\n", name)
+
+	return nil
+}
+
+func Command(factory *factory.Factory) *cobra.Command {
+	o := options{
+		UnikornFlags: &factory.UnikornFlags,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "use-profile <name>",
+		Short: "Set the current profile, creating it if it doesn't exist",
+		Long: `Set the current profile, creating it if it doesn't exist.
+
+Any of --kubeconfig, --context, --identity-namespace, --region-namespace
+and --compute-namespace that are specified are saved in the profile.
+
+Examples:
+  # Create a profile for a staging environment and switch to it
+  unicli config use-profile staging --kubeconfig ~/.kube/staging.yaml
+
+  # Create a profile for a management cluster in a merged configuration
+  unicli config use-profile production --context production-eu
+
+  # Switch back to the default profile
+  unicli config use-profile default`,
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: factory.ProfileNameCompletionFunc(),
+		// Don't apply the current profile, only flags given on the command
+		// line should be saved.
+		PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+			return nil
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return o.execute(cmd, args[0])
+		},
+	}
+
+	return cmd
+}
@@ -0,0 +1,71 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package view
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/nscaledev/unicli/pkg/factory"
+	"github.com/nscaledev/unicli/pkg/profile"
+
+	"sigs.k8s.io/yaml"
+)
+
+type options struct {
+	UnikornFlags *factory.UnikornFlags
+}
+
+func (o *options) execute() error {
+	config, err := profile.Load()
+	if err != nil {
+		return err
+	}
+
+	path, err := profile.Path()
+	if err != nil {
+		return err
+	}
+
+	data, err := yaml.Marshal(config)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("# %s\n", path)
+	fmt.Print(string(data))
+
+	return nil
+}
+
+func Command(factory *factory.Factory) *cobra.Command {
+	o := options{
+		UnikornFlags: &factory.UnikornFlags,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "view",
+		Short: "Show the CLI configuration",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return o.execute()
+		},
+	}
+
+	return cmd
+}
@@ -18,47 +18,210 @@ package clustermanager
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"os"
 	"os/exec"
-	"strings"
-	"time"
+	"os/signal"
+	"path/filepath"
 
 	"github.com/spf13/cobra"
 
 	"github.com/nscaledev/unicli/pkg/factory"
-	kubernetesv1 "github.com/unikorn-cloud/kubernetes/pkg/apis/unikorn/v1alpha1"
+	"github.com/nscaledev/unicli/pkg/flags"
+	"github.com/nscaledev/unicli/pkg/kubeconfig"
+	"github.com/nscaledev/unicli/pkg/resolve"
+	"github.com/nscaledev/unicli/pkg/vcluster"
+	"github.com/unikorn-cloud/core/pkg/constants"
+
+	"k8s.io/client-go/rest"
 
-	corev1 "k8s.io/api/core/v1"
-	"k8s.io/apimachinery/pkg/api/errors"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 type options struct {
 	UnikornFlags *factory.UnikornFlags
+
+	organization *flags.OrganizationFlags
+	shell        bool
+}
+
+func (o *options) AddFlags(cmd *cobra.Command, factory *factory.Factory) error {
+	if err := o.organization.AddFlags(cmd, factory, false); err != nil {
+		return err
+	}
+
+	cmd.Flags().BoolVar(&o.shell, "shell", true, "Run a shell configured to use the cluster manager, otherwise run in the foreground until interrupted.")
+
+	return nil
+}
+
+func (o *options) validate(ctx context.Context, cli client.Client) error {
+	validators := []func(context.Context, client.Client) error{
+		o.organization.Validate,
+	}
+
+	for _, validator := range validators {
+		if err := validator(ctx, cli); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (o *options) execute(ctx context.Context, cli client.Client, config *rest.Config, identifier string) error {
+	manager, err := resolve.ClusterManager(ctx, cli, flags.Scope(o.UnikornFlags, o.organization, nil), identifier)
+	if err != nil {
+		return err
+	}
+
+	pod, err := vcluster.GetPod(ctx, cli, manager.Namespace, manager.Name)
+	if err != nil {
+		return err
+	}
+
+	vclusterConfig, err := vcluster.ClientConfig(ctx, cli, manager.Namespace, manager.Name)
+	if err != nil {
+		return err
+	}
+
+	forward, err := vcluster.NewPortForward(ctx, config, pod)
+	if err != nil {
+		return err
+	}
+
+	defer forward.Close()
+
+	name := manager.Labels[constants.NameLabel]
+
+	clusterConfig, err := kubeconfig.Rename(forward.ClientConfig(vclusterConfig), name)
+	if err != nil {
+		return err
+	}
+
+	dir, err := os.MkdirTemp("", "unicli-")
+	if err != nil {
+		return err
+	}
+
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "kubeconfig")
+
+	if err := kubeconfig.Write(path, clusterConfig); err != nil {
+		return err
+	}
+
+	fmt.Printf("Connected to cluster manager %s on localhost:%d\n", name, forward.Port)
+
+	if o.shell {
+		return runShell(path, forward)
+	}
+
+	return runForeground(ctx, path, forward)
+}
+
+// runShell starts an interactive shell configured to use the cluster manager,
+// the connection is closed when the shell exits.
+func runShell(path string, forward *vcluster.PortForward) error {
+	shell := os.Getenv("SHELL")
+	if shell == "" {
+		shell = "/bin/sh"
+	}
+
+	// The shell is in the foreground and will handle interrupts itself, we
+	// must not exit and tear down the connection underneath it.
+	signal.Ignore(os.Interrupt)
+	defer signal.Reset(os.Interrupt)
+
+	fmt.Println("Starting a shell with KUBECONFIG set, exit the shell to disconnect")
+
+	cmd := exec.Command(shell)
+	cmd.Stdin = os.Stdin
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	cmd.Env = append(os.Environ(), "KUBECONFIG="+path)
+
+	go func() {
+		if err := <-forward.Done(); err != nil {
+			fmt.Fprintf(os.Stderr, "connection to cluster manager lost: %v\n", err)
+		}
+	}()
+
+	if err := cmd.Run(); err != nil {
+		var exitErr *exec.ExitError
+
+		// The exit status of the last command run in the shell isn't
+		// our concern.
+		if errors.As(err, &exitErr) {
+			return nil
+		}
+
+		return fmt.Errorf("failed to run shell: %w", err)
+	}
+
+	return nil
+}
+
+// runForeground keeps the connection open until interrupted.
+func runForeground(ctx context.Context, path string, forward *vcluster.PortForward) error {
+	fmt.Printf("export KUBECONFIG=%s\n", path)
+	fmt.Println("Press Ctrl-C to disconnect")
+
+	select {
+	case <-ctx.Done():
+		return nil
+	case err := <-forward.Done():
+		return fmt.Errorf("connection to cluster manager lost: %w", err)
+	}
 }
 
 func Command(factory *factory.Factory) *cobra.Command {
+	unikornFlags := &factory.UnikornFlags
+
 	o := options{
-		UnikornFlags: &factory.UnikornFlags,
+		UnikornFlags: unikornFlags,
+		organization: flags.NewOrganizationFlags(unikornFlags),
 	}
 
 	cmd := &cobra.Command{
-		Use:   "clustermanager <name>",
+		Use:   "clustermanager <name|id>",
 		Short: "Connect to a kubernetes cluster manager",
+		Long: `Connect to a kubernetes cluster manager.
+
+A port forward is established to the cluster manager's virtual cluster, and a
+shell is started with KUBECONFIG set to access it.  The connection is closed
+when the shell exits.
+
+Examples:
+  # Start a shell connected to the cluster manager
+  unicli connect clustermanager default --organization my-org
+
+  # Keep the connection open in the foreground until Ctrl-C
+  unicli connect clustermanager default --organization my-org --shell=false`,
 		Aliases: []string{
 			"cm",
 		},
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
-			defer cancel()
+			ctx := cmd.Context()
+
+			config, err := factory.RESTConfig()
+			if err != nil {
+				return err
+			}
 
-			client, err := factory.Client()
+			client, err := factory.Client(ctx)
 			if err != nil {
 				return err
 			}
 
-			if err := o.execute(ctx, client, args[0]); err != nil {
+			if err := o.validate(ctx, client); err != nil {
+				return err
+			}
+
+			if err := o.execute(ctx, client, config, args[0]); err != nil {
 				return err
 			}
 
@@ -66,54 +229,9 @@ func Command(factory *factory.Factory) *cobra.Command {
 		},
 	}
 
-	return cmd
-}
-
-func (o *options) execute(ctx context.Context, cli client.Client, name string) error {
-	// List all namespaces
-	namespaces := &corev1.NamespaceList{}
-	if err := cli.List(ctx, namespaces); err != nil {
-		return fmt.Errorf("failed to list namespaces: %w", err)
+	if err := o.AddFlags(cmd, factory); err != nil {
+		panic(err)
 	}
 
-	// Search for the clustermanager in all namespaces
-	var manager *kubernetesv1.ClusterManager
-	for _, namespace := range namespaces.Items {
-		manager = &kubernetesv1.ClusterManager{}
-		err := cli.Get(ctx, client.ObjectKey{Name: name, Namespace: namespace.Name}, manager)
-		if err == nil {
-			break
-		}
-		if !errors.IsNotFound(err) {
-			return fmt.Errorf("failed to get cluster manager %s: %w", name, err)
-		}
-		manager = nil
-	}
-
-	if manager == nil {
-		return fmt.Errorf("cluster manager %s not found in any namespace", name)
-	}
-
-	// Get the vcluster pod name
-	cmd := exec.Command("sh", "-c", fmt.Sprintf("kubectl get pods -n %s -o name | grep ^pod/vcluster", manager.Namespace))
-	output, err := cmd.Output()
-	if err != nil {
-		return fmt.Errorf("failed to get vcluster pod: %w", err)
-	}
-	podName := strings.TrimSpace(string(output))
-	podName = strings.TrimPrefix(podName, "pod/")
-	podName = strings.TrimSuffix(podName, "-0")
-
-	// Connect to the vcluster
-	connectCmd := exec.Command("sh", "-c", fmt.Sprintf("vcluster connect %s -n %s > /dev/null 2>&1 &", podName, manager.Namespace))
-	connectCmd.Stdout = nil
-	connectCmd.Stderr = nil
-
-	fmt.Println(connectCmd.String())
-	if err := connectCmd.Start(); err != nil {
-		return fmt.Errorf("failed to start vcluster connect command: %w", err)
-	}
-
-	fmt.Printf("Connecting to cluster manager %s in namespace %s, please wait...\n", name, manager.Namespace)
-	return nil
+	return cmd
 }
@@ -20,6 +20,8 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/nscaledev/unicli/pkg/connect/clustermanager"
+	"github.com/nscaledev/unicli/pkg/connect/kubernetescluster"
+	"github.com/nscaledev/unicli/pkg/connect/virtualkubernetescluster"
 	"github.com/nscaledev/unicli/pkg/factory"
 )
 
@@ -31,6 +33,8 @@ func Command(factory *factory.Factory) *cobra.Command {
 
 	cmd.AddCommand(
 		clustermanager.Command(factory),
+		kubernetescluster.Command(factory),
+		virtualkubernetescluster.Command(factory),
 	)
 
 	return cmd
@@ -19,8 +19,13 @@ package create
 import (
 	"github.com/spf13/cobra"
 
+	"github.com/nscaledev/unicli/pkg/create/computeinstance"
 	"github.com/nscaledev/unicli/pkg/create/group"
+	"github.com/nscaledev/unicli/pkg/create/kubernetescluster"
+	"github.com/nscaledev/unicli/pkg/create/network"
 	"github.com/nscaledev/unicli/pkg/create/organization"
+	"github.com/nscaledev/unicli/pkg/create/project"
+	"github.com/nscaledev/unicli/pkg/create/securitygroup"
 	"github.com/nscaledev/unicli/pkg/create/user"
 	"github.com/nscaledev/unicli/pkg/factory"
 )
@@ -32,8 +37,13 @@ func Command(factory *factory.Factory) *cobra.Command {
 	}
 
 	cmd.AddCommand(
+		computeinstance.Command(factory),
 		group.Command(factory),
+		kubernetescluster.Command(factory),
+		network.Command(factory),
 		organization.Command(factory),
+		project.Command(factory),
+		securitygroup.Command(factory),
 		user.Command(factory),
 	)
 
@@ -0,0 +1,392 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package computeinstance
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"slices"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/nscaledev/unicli/pkg/errors"
+	"github.com/nscaledev/unicli/pkg/factory"
+	"github.com/nscaledev/unicli/pkg/flags"
+	"github.com/nscaledev/unicli/pkg/resolve"
+	"github.com/nscaledev/unicli/pkg/util"
+	computev1 "github.com/unikorn-cloud/compute/pkg/apis/unikorn/v1alpha1"
+	unikornv1core "github.com/unikorn-cloud/core/pkg/apis/unikorn/v1alpha1"
+	"github.com/unikorn-cloud/core/pkg/constants"
+	coreutil "github.com/unikorn-cloud/core/pkg/util"
+	identityv1 "github.com/unikorn-cloud/identity/pkg/apis/unikorn/v1alpha1"
+	regionv1 "github.com/unikorn-cloud/region/pkg/apis/unikorn/v1alpha1"
+	regionconstants "github.com/unikorn-cloud/region/pkg/constants"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+type createComputeInstanceOptions struct {
+	UnikornFlags *factory.UnikornFlags
+
+	organization *flags.OrganizationFlags
+	project      *flags.ProjectFlags
+	network      *flags.NetworkFlags
+	wait         *flags.WaitFlags
+
+	name                   string
+	description            string
+	flavor                 string
+	image                  string
+	replicas               int
+	diskSize               string
+	publicIP               bool
+	securityGroups         []string
+	allowedSourceAddresses []string
+
+	names  []string
+	spec   computev1.ComputeInstanceSpec
+	region *regionv1.Region
+}
+
+func (o *createComputeInstanceOptions) AddFlags(cmd *cobra.Command, factory *factory.Factory) error {
+	cmd.Flags().StringVar(&o.name, "name", "", "Instance name, when creating multiple replicas this is suffixed with the replica number.")
+	cmd.Flags().StringVar(&o.description, "description", "", "A verbose instance description.")
+	cmd.Flags().StringVar(&o.flavor, "flavor", "", "Flavor ID or name, as shown by \"get flavor\".")
+	cmd.Flags().StringVar(&o.image, "image", "", "Image ID, as shown by \"get image\".")
+	cmd.Flags().IntVar(&o.replicas, "replicas", 1, "Number of instances to create.")
+	cmd.Flags().StringVar(&o.diskSize, "disk-size", "", "Persistent root disk size e.g. 100Gi, overrides the flavor's ephemeral disk.")
+	cmd.Flags().BoolVar(&o.publicIP, "public-ip", false, "Allocate a public IP address.")
+	cmd.Flags().StringSliceVar(&o.securityGroups, "security-group", nil, "Security group name or ID, may be specified more than once.")
+	cmd.Flags().StringSliceVar(&o.allowedSourceAddresses, "allowed-source-address", nil, "Prefix the instance may route traffic from, may be specified more than once.")
+
+	requiredFlags := []string{
+		"name",
+		"flavor",
+		"image",
+	}
+
+	for _, flag := range requiredFlags {
+		if err := cmd.MarkFlagRequired(flag); err != nil {
+			return err
+		}
+	}
+
+	if err := o.organization.AddFlags(cmd, factory, true); err != nil {
+		return err
+	}
+
+	if err := o.project.AddFlags(cmd, factory, true); err != nil {
+		return err
+	}
+
+	if err := o.network.AddFlags(cmd, factory, true); err != nil {
+		return err
+	}
+
+	o.wait.AddFlags(cmd)
+
+	return nil
+}
+
+// validateInstances generates the instance names, and ensures they aren't already
+// in use on the network, as that would result in hostname collisions.
+func (o *createComputeInstanceOptions) validateInstances(ctx context.Context, cli client.Client) error {
+	if o.replicas < 1 {
+		return fmt.Errorf("%w: replicas must be at least 1", errors.ErrValidation)
+	}
+
+	o.names = []string{o.name}
+
+	if o.replicas > 1 {
+		o.names = make([]string, o.replicas)
+
+		for i := range o.replicas {
+			o.names[i] = fmt.Sprintf("%s-%d", o.name, i+1)
+		}
+	}
+
+	options := &client.ListOptions{
+		Namespace: o.UnikornFlags.ComputeNamespace,
+		LabelSelector: labels.SelectorFromSet(labels.Set{
+			constants.OrganizationLabel:  o.organization.Organization.Name,
+			constants.ProjectLabel:       o.project.Project.Name,
+			regionconstants.NetworkLabel: o.network.Network.Name,
+		}),
+	}
+
+	var resources computev1.ComputeInstanceList
+
+	if err := cli.List(ctx, &resources, options); err != nil {
+		return err
+	}
+
+	for i := range resources.Items {
+		if name := resources.Items[i].Labels[constants.NameLabel]; slices.Contains(o.names, name) {
+			return fmt.Errorf("%w: instance %s already exists on network %s", errors.ErrValidation, name, o.network.NetworkName)
+		}
+	}
+
+	return nil
+}
+
+// validateSecurityGroups resolves security groups, these must be attached to the
+// same network as the instance.
+func (o *createComputeInstanceOptions) validateSecurityGroups(ctx context.Context, cli client.Client) ([]string, error) {
+	if len(o.securityGroups) == 0 {
+		return nil, nil
+	}
+
+	scope := &resolve.Scope{
+		IdentityNamespace: o.UnikornFlags.IdentityNamespace,
+		Namespace:         o.UnikornFlags.RegionNamespace,
+		Labels: labels.Set{
+			regionconstants.NetworkLabel: o.network.Network.Name,
+		},
+	}
+
+	ids := make([]string, len(o.securityGroups))
+
+	for i, identifier := range o.securityGroups {
+		securityGroup, err := resolve.SecurityGroup(ctx, cli, scope, identifier)
+		if err != nil {
+			return nil, err
+		}
+
+		ids[i] = securityGroup.Name
+	}
+
+	return ids, nil
+}
+
+func (o *createComputeInstanceOptions) validateSpec(ctx context.Context, cli client.Client) error {
+	regionID := o.network.Network.Labels[regionconstants.RegionLabel]
+
+	region, err := util.GetRegion(ctx, cli, o.UnikornFlags.RegionNamespace, regionID)
+	if err != nil {
+		return err
+	}
+
+	o.region = region
+
+	flavor, err := util.ResolveFlavor(region, o.flavor)
+	if err != nil {
+		return err
+	}
+
+	if err := util.ValidateImage(o.image); err != nil {
+		return err
+	}
+
+	o.spec = computev1.ComputeInstanceSpec{
+		MachineGeneric: unikornv1core.MachineGeneric{
+			FlavorID: flavor,
+			ImageID:  o.image,
+		},
+	}
+
+	if o.diskSize != "" {
+		diskSize, err := resource.ParseQuantity(o.diskSize)
+		if err != nil {
+			return fmt.Errorf("%w: disk size %q is not a valid quantity", errors.ErrValidation, o.diskSize)
+		}
+
+		o.spec.DiskSize = &diskSize
+	}
+
+	securityGroupIDs, err := o.validateSecurityGroups(ctx, cli)
+	if err != nil {
+		return err
+	}
+
+	allowedSourceAddresses := make([]unikornv1core.IPv4Prefix, len(o.allowedSourceAddresses))
+
+	for i, address := range o.allowedSourceAddresses {
+		ip, prefix, err := net.ParseCIDR(address)
+		if err != nil || ip.To4() == nil {
+			return fmt.Errorf("%w: allowed source address %q is not a valid IPv4 prefix", errors.ErrValidation, address)
+		}
+
+		allowedSourceAddresses[i] = unikornv1core.IPv4Prefix{IPNet: *prefix}
+	}
+
+	if o.publicIP || len(securityGroupIDs) > 0 || len(allowedSourceAddresses) > 0 {
+		o.spec.Networking = &computev1.ComputeInstanceNetworking{
+			PublicIP:               o.publicIP,
+			SecurityGroupIDs:       securityGroupIDs,
+			AllowedSourceAddresses: allowedSourceAddresses,
+		}
+	}
+
+	return nil
+}
+
+// validateQuota ensures all the replicas fit within the organization's quota,
+// rather than failing part way through creating them.
+func (o *createComputeInstanceOptions) validateQuota(ctx context.Context, cli client.Client) error {
+	allocations := util.ComputeInstanceAllocations(o.region, o.spec.FlavorID)
+
+	for i := range allocations {
+		allocations[i].Committed.Set(allocations[i].Committed.Value() * int64(len(o.names)))
+	}
+
+	allocation := &identityv1.Allocation{
+		Spec: identityv1.AllocationSpec{
+			Allocations: allocations,
+		},
+	}
+
+	return util.CheckQuota(ctx, cli, o.organization.Organization.Name, allocation)
+}
+
+func (o *createComputeInstanceOptions) validate(ctx context.Context, cli client.Client) error {
+	validators := []func(context.Context, client.Client) error{
+		o.organization.Validate,
+		o.project.Validate,
+		o.network.Validate,
+		o.validateInstances,
+		o.validateSpec,
+		o.validateQuota,
+	}
+
+	for _, validator := range validators {
+		if err := validator(ctx, cli); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (o *createComputeInstanceOptions) execute(ctx context.Context, cli client.Client) error {
+	instances := make([]*computev1.ComputeInstance, len(o.names))
+
+	for i, name := range o.names {
+		instance := &computev1.ComputeInstance{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: o.UnikornFlags.ComputeNamespace,
+				Name:      coreutil.GenerateResourceID(),
+				Labels: map[string]string{
+					constants.OrganizationLabel:  o.organization.Organization.Name,
+					constants.ProjectLabel:       o.project.Project.Name,
+					constants.NameLabel:          name,
+					regionconstants.RegionLabel:  o.region.Name,
+					regionconstants.NetworkLabel: o.network.Network.Name,
+				},
+			},
+			Spec: *o.spec.DeepCopy(),
+		}
+
+		if o.description != "" {
+			instance.Annotations = map[string]string{
+				constants.DescriptionAnnotation: o.description,
+			}
+		}
+
+		util.SetPrincipal(instance, o.organization.Organization.Name, o.project.Project.Name)
+
+		// Like the compute service, each instance is charged against the organization's
+		// quota, and the compute controller releases it on deprovisioning.
+		allocation, err := util.CreateAllocation(ctx, cli, o.project.Project.Status.Namespace, instance, util.ComputeInstanceAllocations(o.region, o.spec.FlavorID))
+		if err != nil {
+			return err
+		}
+
+		if err := cli.Create(ctx, instance); err != nil {
+			if cerr := util.DeleteAllocation(ctx, cli, allocation); cerr != nil {
+				return fmt.Errorf("%w: %w", err, cerr)
+			}
+
+			return err
+		}
+
+		instances[i] = instance
+	}
+
+	for i, instance := range instances {
+		if err := o.wait.WaitForProvisioned(ctx, cli, "instance", o.names[i], instance); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func Command(factory *factory.Factory) *cobra.Command {
+	unikornFlags := &factory.UnikornFlags
+	organizationFlags := flags.NewOrganizationFlags(unikornFlags)
+	projectFlags := flags.NewProjectFlags(unikornFlags, organizationFlags)
+
+	o := createComputeInstanceOptions{
+		UnikornFlags: unikornFlags,
+		organization: organizationFlags,
+		project:      projectFlags,
+		network:      flags.NewNetworkFlags(unikornFlags, organizationFlags, projectFlags),
+		wait:         flags.NewWaitFlags(15 * time.Minute),
+	}
+
+	cmd := &cobra.Command{
+		Use:   "instance",
+		Short: "Create a compute instance",
+		Long: `Create a compute instance.
+
+Instances are created on a network, in the network's region.  When more than
+one replica is requested, each instance is named after --name with its replica
+number appended.  As with the API, each instance is allocated from the
+organization's servers and GPUs quota.
+
+Instances cannot be started, stopped or rebooted with unicli.  The
+ComputeInstance resource has no desired power state, power control is only
+provided by the compute API at /api/v2/instances/{id}/start, stop and reboot,
+which needs an access token that unicli, using only a Kubernetes
+configuration, doesn't have.  The current power state is shown by
+"describe instance".`,
+		Aliases: []string{
+			"computeinstance",
+			"ci",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			client, err := factory.Client(ctx)
+			if err != nil {
+				return err
+			}
+
+			if err := o.validate(ctx, client); err != nil {
+				return err
+			}
+
+			if err := o.execute(ctx, client); err != nil {
+				return err
+			}
+
+			return nil
+		},
+	}
+
+	if err := o.AddFlags(cmd, factory); err != nil {
+		panic(err)
+	}
+
+	return cmd
+}
@@ -20,13 +20,14 @@ import (
 	"context"
 	"fmt"
 	"slices"
-	"time"
 
 	"github.com/spf13/cobra"
 
 	"github.com/nscaledev/unicli/pkg/errors"
 	"github.com/nscaledev/unicli/pkg/factory"
 	"github.com/nscaledev/unicli/pkg/flags"
+	"github.com/nscaledev/unicli/pkg/resolve"
+	"github.com/nscaledev/unicli/pkg/util"
 	"github.com/unikorn-cloud/core/pkg/constants"
 	coreutil "github.com/unikorn-cloud/core/pkg/util"
 	identityv1 "github.com/unikorn-cloud/identity/pkg/apis/unikorn/v1alpha1"
@@ -118,63 +119,45 @@ func (o *createGroupOptions) validateRoles(ctx context.Context, cli client.Clien
 	slices.Sort(o.roles)
 	o.roles = slices.Compact(o.roles)
 
-	options := &client.ListOptions{
-		Namespace: o.UnikornFlags.IdentityNamespace,
-	}
-
-	var resources identityv1.RoleList
-
-	if err := cli.List(ctx, &resources, options); err != nil {
-		return err
+	scope := &resolve.Scope{
+		IdentityNamespace: o.UnikornFlags.IdentityNamespace,
+		Namespace:         o.UnikornFlags.IdentityNamespace,
 	}
 
 	o.roleIDs = make([]string, len(o.roles))
 
 	for i, role := range o.roles {
-		indexer := func(r identityv1.Role) bool {
-			return r.Labels[constants.NameLabel] == role
+		resource, err := resolve.Role(ctx, cli, scope, role)
+		if err != nil {
+			return err
 		}
 
-		index := slices.IndexFunc(resources.Items, indexer)
-		if index < 0 {
-			return fmt.Errorf("%w: unable to find role %s", errors.ErrValidation, role)
+		if resource.Spec.Protected {
+			return fmt.Errorf("%w: role %s is protected and cannot be granted", errors.ErrValidation, role)
 		}
 
-		o.roleIDs[i] = resources.Items[index].Name
+		o.roleIDs[i] = resource.Name
 	}
 
 	return nil
 }
 
-// validateUsers ensures the roles exist and sets the IDs for use later.
+// validateUsers ensures the users are members of the organization and sets the
+// organization user IDs for use later.
 func (o *createGroupOptions) validateUsers(ctx context.Context, cli client.Client) error {
 	// Remove duplicates.
 	slices.Sort(o.users)
 	o.users = slices.Compact(o.users)
 
-	options := &client.ListOptions{
-		Namespace: o.organization.Organization.Status.Namespace,
-	}
-
-	var resources identityv1.UserList
-
-	if err := cli.List(ctx, &resources, options); err != nil {
-		return err
-	}
-
-	o.userIDs = make([]string, len(o.roles))
+	o.userIDs = make([]string, len(o.users))
 
 	for i, user := range o.users {
-		indexer := func(u identityv1.User) bool {
-			return u.Spec.Subject == user
-		}
-
-		index := slices.IndexFunc(resources.Items, indexer)
-		if index < 0 {
-			return fmt.Errorf("%w: unable to find user %s", errors.ErrValidation, user)
+		organizationUser, err := util.GetOrganizationUser(ctx, cli, o.UnikornFlags.IdentityNamespace, o.organization.Organization, user)
+		if err != nil {
+			return err
 		}
 
-		o.userIDs[i] = resources.Items[index].Name
+		o.userIDs[i] = organizationUser.Name
 	}
 
 	return nil
@@ -198,8 +181,15 @@ func (o *createGroupOptions) validate(ctx context.Context, cli client.Client) er
 }
 
 func (o *createGroupOptions) execute(ctx context.Context, cli client.Client) error {
-	// TODO: we need to create organization users and link them to the group
-	// not the underlying user.
+	subjects := make([]identityv1.GroupSubject, len(o.users))
+
+	for i, user := range o.users {
+		subjects[i] = identityv1.GroupSubject{
+			ID:    user,
+			Email: user,
+		}
+	}
+
 	group := &identityv1.Group{
 		ObjectMeta: metav1.ObjectMeta{
 			Namespace: o.organization.Organization.Status.Namespace,
@@ -210,8 +200,9 @@ func (o *createGroupOptions) execute(ctx context.Context, cli client.Client) err
 			},
 		},
 		Spec: identityv1.GroupSpec{
-			RoleIDs: o.roleIDs,
-			UserIDs: o.userIDs,
+			RoleIDs:  o.roleIDs,
+			UserIDs:  o.userIDs,
+			Subjects: subjects,
 		},
 	}
 
@@ -244,10 +235,9 @@ func Command(factory *factory.Factory) *cobra.Command {
 		Use:   "group",
 		Short: "Create a group",
 		RunE: func(cmd *cobra.Command, args []string) error {
-			ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
-			defer cancel()
+			ctx := cmd.Context()
 
-			client, err := factory.Client()
+			client, err := factory.Client(ctx)
 			if err != nil {
 				return err
 			}
@@ -0,0 +1,701 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetescluster
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"os"
+	"time"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/spf13/cobra"
+
+	"github.com/nscaledev/unicli/pkg/errors"
+	"github.com/nscaledev/unicli/pkg/factory"
+	"github.com/nscaledev/unicli/pkg/flags"
+	"github.com/nscaledev/unicli/pkg/util"
+	unikornv1core "github.com/unikorn-cloud/core/pkg/apis/unikorn/v1alpha1"
+	"github.com/unikorn-cloud/core/pkg/constants"
+	coreutil "github.com/unikorn-cloud/core/pkg/util"
+	identityv1 "github.com/unikorn-cloud/identity/pkg/apis/unikorn/v1alpha1"
+	kubernetesv1 "github.com/unikorn-cloud/kubernetes/pkg/apis/unikorn/v1alpha1"
+	regionv1 "github.com/unikorn-cloud/region/pkg/apis/unikorn/v1alpha1"
+	regionconstants "github.com/unikorn-cloud/region/pkg/constants"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/yaml"
+)
+
+// defaultClusterManagerName is used when a project has no cluster manager and
+// the user didn't ask for a specific one.
+const defaultClusterManagerName = "default"
+
+// specFlags are mutually exclusive with a manifest, which defines the whole
+// cluster specification.
+//
+//nolint:gochecknoglobals
+var specFlags = []string{
+	"version",
+	"node-network",
+	"pod-network",
+	"service-network",
+	"dns-nameservers",
+	"image",
+	"control-plane-flavor",
+	"control-plane-replicas",
+	"workload-pool",
+}
+
+type createKubernetesClusterOptions struct {
+	UnikornFlags *factory.UnikornFlags
+
+	organization *flags.OrganizationFlags
+	project      *flags.ProjectFlags
+	region       *flags.RegionFlags
+	wait         *flags.WaitFlags
+
+	filename             string
+	name                 string
+	description          string
+	clusterManagerName   string
+	version              string
+	nodeNetwork          string
+	podNetwork           string
+	serviceNetwork       string
+	dnsNameservers       []string
+	image                string
+	controlPlaneFlavor   string
+	controlPlaneReplicas int
+	workloadPools        flags.WorkloadPoolsFlag
+
+	// spec is built from either flags or a manifest during validation.
+	spec kubernetesv1.KubernetesClusterSpec
+	// clusterManager is set when a new cluster manager needs to be created.
+	clusterManager *kubernetesv1.ClusterManager
+}
+
+func (o *createKubernetesClusterOptions) AddFlags(cmd *cobra.Command, factory *factory.Factory) error {
+	cmd.Flags().StringVarP(&o.filename, "filename", "f", "", "A KubernetesCluster manifest whose specification is used in place of flags.")
+	cmd.Flags().StringVar(&o.name, "name", "", "Cluster name.")
+	cmd.Flags().StringVar(&o.description, "description", "", "A verbose cluster description.")
+	cmd.Flags().StringVar(&o.clusterManagerName, "cluster-manager", "", "Cluster manager name, created if it doesn't exist. Defaults to the project's only cluster manager, or a new one.")
+	cmd.Flags().StringVar(&o.version, "version", "", "Kubernetes version e.g. v1.33.4, this should match the version installed on the image.")
+	cmd.Flags().StringVar(&o.nodeNetwork, "node-network", "192.168.0.0/24", "Node network prefix.")
+	cmd.Flags().StringVar(&o.podNetwork, "pod-network", "10.0.0.0/16", "Pod network prefix.")
+	cmd.Flags().StringVar(&o.serviceNetwork, "service-network", "172.16.0.0/12", "Service network prefix.")
+	cmd.Flags().StringSliceVar(&o.dnsNameservers, "dns-nameservers", []string{"8.8.8.8"}, "DNS nameservers for the node network.")
+	cmd.Flags().StringVar(&o.image, "image", "", "Image ID for the control plane, and workload pools that don't specify one.")
+	cmd.Flags().StringVar(&o.controlPlaneFlavor, "control-plane-flavor", "", "Flavor ID or name for the control plane.")
+	cmd.Flags().IntVar(&o.controlPlaneReplicas, "control-plane-replicas", 3, "Number of control plane nodes.")
+	cmd.Flags().Var(&o.workloadPools, "workload-pool", "Workload pool in the form name=...,flavor=...,image=...,replicas=..., flavors may be an ID or name, images must be an ID, may be specified more than once.")
+
+	if err := cmd.MarkFlagRequired("name"); err != nil {
+		return err
+	}
+
+	for _, flag := range specFlags {
+		cmd.MarkFlagsMutuallyExclusive("filename", flag)
+	}
+
+	if err := o.organization.AddFlags(cmd, factory, true); err != nil {
+		return err
+	}
+
+	if err := o.project.AddFlags(cmd, factory, true); err != nil {
+		return err
+	}
+
+	if err := o.region.AddFlags(cmd, factory, false); err != nil {
+		return err
+	}
+
+	o.wait.AddFlags(cmd)
+
+	return nil
+}
+
+// validateCluster ensures the cluster doesn't already exist.
+func (o *createKubernetesClusterOptions) validateCluster(ctx context.Context, cli client.Client) error {
+	options := &client.ListOptions{
+		LabelSelector: labels.SelectorFromSet(labels.Set{
+			constants.OrganizationLabel: o.organization.Organization.Name,
+			constants.ProjectLabel:      o.project.Project.Name,
+			constants.NameLabel:         o.name,
+		}),
+	}
+
+	var resources kubernetesv1.KubernetesClusterList
+
+	if err := cli.List(ctx, &resources, options); err != nil {
+		return err
+	}
+
+	if len(resources.Items) != 0 {
+		return fmt.Errorf("%w: expected no kubernetes clusters to exist with name %s", errors.ErrValidation, o.name)
+	}
+
+	return nil
+}
+
+func parsePrefix(flag, value string) (unikornv1core.IPv4Prefix, error) {
+	ip, prefix, err := net.ParseCIDR(value)
+	if err != nil || ip.To4() == nil {
+		return unikornv1core.IPv4Prefix{}, fmt.Errorf("%w: %s %q is not a valid IPv4 prefix", errors.ErrValidation, flag, value)
+	}
+
+	return unikornv1core.IPv4Prefix{IPNet: *prefix}, nil
+}
+
+// specFromFlags builds the cluster specification from the command line.
+func (o *createKubernetesClusterOptions) specFromFlags() error {
+	required := map[string]string{
+		"version":              o.version,
+		"image":                o.image,
+		"control-plane-flavor": o.controlPlaneFlavor,
+	}
+
+	for flag, value := range required {
+		if value == "" {
+			return fmt.Errorf("%w: --%s must be specified when not using a manifest", errors.ErrValidation, flag)
+		}
+	}
+
+	if len(o.workloadPools) == 0 {
+		return fmt.Errorf("%w: at least one --workload-pool must be specified when not using a manifest", errors.ErrValidation)
+	}
+
+	version, err := semver.NewVersion(o.version)
+	if err != nil {
+		return fmt.Errorf("%w: version %q is not a valid semantic version", errors.ErrValidation, o.version)
+	}
+
+	nodeNetwork, err := parsePrefix("node network", o.nodeNetwork)
+	if err != nil {
+		return err
+	}
+
+	podNetwork, err := parsePrefix("pod network", o.podNetwork)
+	if err != nil {
+		return err
+	}
+
+	serviceNetwork, err := parsePrefix("service network", o.serviceNetwork)
+	if err != nil {
+		return err
+	}
+
+	dnsNameservers := make([]unikornv1core.IPv4Address, len(o.dnsNameservers))
+
+	for i, nameserver := range o.dnsNameservers {
+		ip := net.ParseIP(nameserver)
+		if ip == nil || ip.To4() == nil {
+			return fmt.Errorf("%w: DNS nameserver %q is not a valid IPv4 address", errors.ErrValidation, nameserver)
+		}
+
+		dnsNameservers[i] = unikornv1core.IPv4Address{IP: ip}
+	}
+
+	pools := make([]kubernetesv1.KubernetesWorkloadPoolSpec, len(o.workloadPools))
+
+	for i, pool := range o.workloadPools {
+		image := pool.Image
+		if image == "" {
+			image = o.image
+		}
+
+		pools[i] = kubernetesv1.KubernetesWorkloadPoolSpec{
+			Name: pool.Name,
+			MachineGeneric: unikornv1core.MachineGeneric{
+				ImageID:  image,
+				FlavorID: pool.Flavor,
+				Replicas: pool.Replicas,
+			},
+		}
+	}
+
+	o.spec = kubernetesv1.KubernetesClusterSpec{
+		Version: unikornv1core.SemanticVersion{
+			Version: *version,
+		},
+		Network: kubernetesv1.KubernetesClusterNetworkSpec{
+			NetworkGeneric: unikornv1core.NetworkGeneric{
+				NodeNetwork:    nodeNetwork,
+				DNSNameservers: dnsNameservers,
+			},
+			PodNetwork:     podNetwork,
+			ServiceNetwork: serviceNetwork,
+		},
+		ControlPlane: unikornv1core.MachineGeneric{
+			ImageID:  o.image,
+			FlavorID: o.controlPlaneFlavor,
+			Replicas: o.controlPlaneReplicas,
+		},
+		WorkloadPools: kubernetesv1.KubernetesClusterWorkloadPoolsSpec{
+			Pools: pools,
+		},
+	}
+
+	return nil
+}
+
+// specFromManifest loads the cluster specification from a manifest, metadata
+// is ignored as that is defined by the organization and project.
+func (o *createKubernetesClusterOptions) specFromManifest() error {
+	data, err := os.ReadFile(o.filename)
+	if err != nil {
+		return err
+	}
+
+	var cluster kubernetesv1.KubernetesCluster
+
+	if err := yaml.UnmarshalStrict(data, &cluster); err != nil {
+		return fmt.Errorf("%w: unable to parse manifest %s: %w", errors.ErrValidation, o.filename, err)
+	}
+
+	o.spec = cluster.Spec
+
+	return nil
+}
+
+// validateSpec builds the cluster specification, then resolves flavors against
+// the region, checks image IDs and that the networks don't collide with one
+// another.
+func (o *createKubernetesClusterOptions) validateSpec(ctx context.Context, cli client.Client) error {
+	build := o.specFromFlags
+
+	if o.filename != "" {
+		build = o.specFromManifest
+	}
+
+	if err := build(); err != nil {
+		return err
+	}
+
+	if o.region.Region != nil {
+		o.spec.RegionID = o.region.Region.Name
+	}
+
+	if o.spec.RegionID == "" {
+		return fmt.Errorf("%w: --region must be specified", errors.ErrValidation)
+	}
+
+	region, err := util.GetRegion(ctx, cli, o.UnikornFlags.RegionNamespace, o.spec.RegionID)
+	if err != nil {
+		return err
+	}
+
+	if o.spec.ControlPlane.FlavorID, err = util.ResolveFlavor(region, o.spec.ControlPlane.FlavorID); err != nil {
+		return err
+	}
+
+	if err := util.ValidateImage(o.spec.ControlPlane.ImageID); err != nil {
+		return err
+	}
+
+	for i := range o.spec.WorkloadPools.Pools {
+		pool := &o.spec.WorkloadPools.Pools[i]
+
+		if pool.FlavorID, err = util.ResolveFlavor(region, pool.FlavorID); err != nil {
+			return err
+		}
+
+		if err := util.ValidateImage(pool.ImageID); err != nil {
+			return err
+		}
+	}
+
+	networks := map[string]*net.IPNet{
+		"node":    &o.spec.Network.NodeNetwork.IPNet,
+		"pod":     &o.spec.Network.PodNetwork.IPNet,
+		"service": &o.spec.Network.ServiceNetwork.IPNet,
+	}
+
+	for _, pair := range [][2]string{{"node", "pod"}, {"node", "service"}, {"pod", "service"}} {
+		if util.PrefixesOverlap(networks[pair[0]], networks[pair[1]]) {
+			return fmt.Errorf("%w: %s network %s overlaps %s network %s", errors.ErrValidation, pair[0], networks[pair[0]], pair[1], networks[pair[1]])
+		}
+	}
+
+	return nil
+}
+
+// latestBundle returns the newest bundle that is neither a preview nor end of life.
+func latestBundle(kind string, names []string, specs []*kubernetesv1.ApplicationBundleSpec) (string, error) {
+	var (
+		name   string
+		newest *kubernetesv1.ApplicationBundleSpec
+	)
+
+	for i, spec := range specs {
+		if spec.Preview || (spec.EndOfLife != nil && spec.EndOfLife.Before(&metav1.Time{Time: time.Now()})) {
+			continue
+		}
+
+		if newest == nil || spec.Version.Compare(&newest.Version) > 0 {
+			name = names[i]
+			newest = spec
+		}
+	}
+
+	if newest == nil {
+		return "", fmt.Errorf("%w: unable to find a usable %s application bundle", errors.ErrValidation, kind)
+	}
+
+	return name, nil
+}
+
+func (o *createKubernetesClusterOptions) validateApplicationBundle(ctx context.Context, cli client.Client) error {
+	if o.spec.ApplicationBundle != "" {
+		return nil
+	}
+
+	var resources kubernetesv1.KubernetesClusterApplicationBundleList
+
+	if err := cli.List(ctx, &resources); err != nil {
+		return err
+	}
+
+	names := make([]string, len(resources.Items))
+	specs := make([]*kubernetesv1.ApplicationBundleSpec, len(resources.Items))
+
+	for i := range resources.Items {
+		names[i] = resources.Items[i].Name
+		specs[i] = &resources.Items[i].Spec
+	}
+
+	bundle, err := latestBundle("kubernetes cluster", names, specs)
+	if err != nil {
+		return err
+	}
+
+	o.spec.ApplicationBundle = bundle
+
+	return nil
+}
+
+// validateClusterManager selects an existing cluster manager in the project,
+// or defines a new one to be created alongside the cluster.
+func (o *createKubernetesClusterOptions) validateClusterManager(ctx context.Context, cli client.Client) error {
+	if o.spec.ClusterManagerID != "" && o.clusterManagerName == "" {
+		return nil
+	}
+
+	l := labels.Set{
+		constants.OrganizationLabel: o.organization.Organization.Name,
+		constants.ProjectLabel:      o.project.Project.Name,
+	}
+
+	if o.clusterManagerName != "" {
+		l[constants.NameLabel] = o.clusterManagerName
+	}
+
+	var resources kubernetesv1.ClusterManagerList
+
+	if err := cli.List(ctx, &resources, &client.ListOptions{LabelSelector: labels.SelectorFromSet(l)}); err != nil {
+		return err
+	}
+
+	switch len(resources.Items) {
+	case 0:
+	case 1:
+		o.spec.ClusterManagerID = resources.Items[0].Name
+
+		return nil
+	default:
+		return fmt.Errorf("%w: project has multiple cluster managers, select one with --cluster-manager", errors.ErrValidation)
+	}
+
+	var bundles kubernetesv1.ClusterManagerApplicationBundleList
+
+	if err := cli.List(ctx, &bundles); err != nil {
+		return err
+	}
+
+	names := make([]string, len(bundles.Items))
+	specs := make([]*kubernetesv1.ApplicationBundleSpec, len(bundles.Items))
+
+	for i := range bundles.Items {
+		names[i] = bundles.Items[i].Name
+		specs[i] = &bundles.Items[i].Spec
+	}
+
+	bundle, err := latestBundle("cluster manager", names, specs)
+	if err != nil {
+		return err
+	}
+
+	name := o.clusterManagerName
+	if name == "" {
+		name = defaultClusterManagerName
+	}
+
+	o.clusterManager = &kubernetesv1.ClusterManager{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: o.project.Project.Status.Namespace,
+			Name:      coreutil.GenerateResourceID(),
+			Labels: map[string]string{
+				constants.OrganizationLabel: o.organization.Organization.Name,
+				constants.ProjectLabel:      o.project.Project.Name,
+				constants.NameLabel:         name,
+			},
+		},
+		Spec: kubernetesv1.ClusterManagerSpec{
+			ApplicationBundle: bundle,
+		},
+	}
+
+	o.spec.ClusterManagerID = o.clusterManager.Name
+
+	return nil
+}
+
+func (o *createKubernetesClusterOptions) validate(ctx context.Context, cli client.Client) error {
+	validators := []func(context.Context, client.Client) error{
+		o.organization.Validate,
+		o.project.Validate,
+		o.region.Validate,
+		o.validateCluster,
+		o.validateSpec,
+		o.validateApplicationBundle,
+		o.validateClusterManager,
+	}
+
+	for _, validator := range validators {
+		if err := validator(ctx, cli); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// createIdentity creates the cloud identity the cluster is provisioned with,
+// along with a physical network for bare-metal nodes to attach to, where the
+// region supports them, as the kubernetes service does.  Deleting the identity
+// cascades to the physical network.
+func (o *createKubernetesClusterOptions) createIdentity(ctx context.Context, cli client.Client, cluster *kubernetesv1.KubernetesCluster) (*regionv1.Identity, error) {
+	tags := unikornv1core.TagList{
+		{
+			Name:  constants.KubernetesClusterLabel,
+			Value: cluster.Name,
+		},
+	}
+
+	identity := &regionv1.Identity{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: o.UnikornFlags.RegionNamespace,
+			Name:      coreutil.GenerateResourceID(),
+			Labels: map[string]string{
+				constants.OrganizationLabel: o.organization.Organization.Name,
+				constants.ProjectLabel:      o.project.Project.Name,
+				constants.NameLabel:         "kubernetes-cluster-" + cluster.Name,
+				regionconstants.RegionLabel: o.region.Region.Name,
+			},
+			Annotations: map[string]string{
+				constants.DescriptionAnnotation: "Identity for Kubernetes cluster " + cluster.Name,
+			},
+		},
+		Spec: regionv1.IdentitySpec{
+			Tags:     tags,
+			Provider: o.region.Region.Spec.Provider,
+		},
+	}
+
+	util.SetPrincipal(identity, o.organization.Organization.Name, o.project.Project.Name)
+
+	if err := cli.Create(ctx, identity); err != nil {
+		return nil, fmt.Errorf("failed to create identity: %w", err)
+	}
+
+	cluster.Annotations[constants.IdentityAnnotation] = identity.Name
+
+	openstack := o.region.Region.Spec.Openstack
+	if openstack == nil || openstack.Network == nil || openstack.Network.ProviderNetworks == nil {
+		return identity, nil
+	}
+
+	network := &regionv1.Network{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: o.UnikornFlags.RegionNamespace,
+			Name:      coreutil.GenerateResourceID(),
+			Labels: map[string]string{
+				constants.OrganizationLabel:   o.organization.Organization.Name,
+				constants.ProjectLabel:        o.project.Project.Name,
+				constants.NameLabel:           "kubernetes-cluster-" + cluster.Name,
+				regionconstants.RegionLabel:   o.region.Region.Name,
+				regionconstants.IdentityLabel: identity.Name,
+			},
+			Annotations: map[string]string{
+				constants.DescriptionAnnotation: "Physical network for cluster " + cluster.Name,
+			},
+		},
+		Spec: regionv1.NetworkSpec{
+			Tags:           tags,
+			Provider:       identity.Spec.Provider,
+			Prefix:         &cluster.Spec.Network.NodeNetwork,
+			DNSNameservers: cluster.Spec.Network.DNSNameservers,
+		},
+	}
+
+	util.SetPrincipal(network, o.organization.Organization.Name, o.project.Project.Name)
+
+	if err := controllerutil.SetOwnerReference(identity, network, cli.Scheme(), controllerutil.WithBlockOwnerDeletion(true)); err != nil {
+		return identity, err
+	}
+
+	if err := cli.Create(ctx, network); err != nil {
+		return identity, fmt.Errorf("failed to create physical network: %w", err)
+	}
+
+	cluster.Annotations[constants.PhysicalNetworkAnnotation] = network.Name
+
+	return identity, nil
+}
+
+// cleanup removes anything created ahead of the cluster, as nothing else will
+// ever clean it up.
+func (o *createKubernetesClusterOptions) cleanup(ctx context.Context, cli client.Client, allocation *identityv1.Allocation, identity *regionv1.Identity) error {
+	if allocation != nil {
+		if err := util.DeleteAllocation(ctx, cli, allocation); err != nil {
+			return err
+		}
+	}
+
+	// Like the region service, cascade to anything the identity owns.
+	if identity != nil {
+		if err := cli.Delete(ctx, identity, client.PropagationPolicy(metav1.DeletePropagationForeground)); client.IgnoreNotFound(err) != nil {
+			return fmt.Errorf("failed to clean up identity %s: %w", identity.Name, err)
+		}
+	}
+
+	if o.clusterManager != nil {
+		if err := cli.Delete(ctx, o.clusterManager, client.PropagationPolicy(metav1.DeletePropagationForeground)); client.IgnoreNotFound(err) != nil {
+			return fmt.Errorf("failed to clean up cluster manager %s: %w", o.clusterManager.Name, err)
+		}
+	}
+
+	return nil
+}
+
+// create emulates the kubernetes service, the cluster is charged against the
+// organization's quota, and provisioned with its own cloud identity.
+func (o *createKubernetesClusterOptions) create(ctx context.Context, cli client.Client, cluster *kubernetesv1.KubernetesCluster) (*identityv1.Allocation, *regionv1.Identity, error) {
+	if o.clusterManager != nil {
+		if err := cli.Create(ctx, o.clusterManager); err != nil {
+			return nil, nil, fmt.Errorf("failed to create cluster manager: %w", err)
+		}
+	}
+
+	allocation, err := util.CreateAllocation(ctx, cli, o.project.Project.Status.Namespace, cluster, util.KubernetesClusterAllocations(o.region.Region, &cluster.Spec))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	identity, err := o.createIdentity(ctx, cli, cluster)
+	if err != nil {
+		return allocation, identity, err
+	}
+
+	if err := cli.Create(ctx, cluster); err != nil {
+		return allocation, identity, err
+	}
+
+	return allocation, identity, nil
+}
+
+func (o *createKubernetesClusterOptions) execute(ctx context.Context, cli client.Client) error {
+	cluster := &kubernetesv1.KubernetesCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: o.project.Project.Status.Namespace,
+			Name:      coreutil.GenerateResourceID(),
+			Labels: map[string]string{
+				constants.OrganizationLabel: o.organization.Organization.Name,
+				constants.ProjectLabel:      o.project.Project.Name,
+				constants.NameLabel:         o.name,
+			},
+			Annotations: map[string]string{},
+		},
+		Spec: o.spec,
+	}
+
+	if o.description != "" {
+		cluster.Annotations[constants.DescriptionAnnotation] = o.description
+	}
+
+	util.SetPrincipal(cluster, o.organization.Organization.Name, o.project.Project.Name)
+
+	if allocation, identity, err := o.create(ctx, cli, cluster); err != nil {
+		if cerr := o.cleanup(ctx, cli, allocation, identity); cerr != nil {
+			return fmt.Errorf("%w: %w", err, cerr)
+		}
+
+		return err
+	}
+
+	return o.wait.WaitForProvisioned(ctx, cli, "kubernetes cluster", o.name, cluster)
+}
+
+func Command(factory *factory.Factory) *cobra.Command {
+	unikornFlags := &factory.UnikornFlags
+	organizationFlags := flags.NewOrganizationFlags(unikornFlags)
+
+	o := createKubernetesClusterOptions{
+		UnikornFlags: unikornFlags,
+		organization: organizationFlags,
+		project:      flags.NewProjectFlags(unikornFlags, organizationFlags),
+		region:       flags.NewRegionFlags(unikornFlags),
+		wait:         flags.NewWaitFlags(30 * time.Minute),
+	}
+
+	cmd := &cobra.Command{
+		Use:   "kubernetescluster",
+		Short: "Create a kubernetes cluster",
+		Aliases: []string{
+			"kc",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			client, err := factory.Client(ctx)
+			if err != nil {
+				return err
+			}
+
+			if err := o.validate(ctx, client); err != nil {
+				return err
+			}
+
+			if err := o.execute(ctx, client); err != nil {
+				return err
+			}
+
+			return nil
+		},
+	}
+
+	if err := o.AddFlags(cmd, factory); err != nil {
+		panic(err)
+	}
+
+	return cmd
+}
@@ -0,0 +1,400 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package network
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/nscaledev/unicli/pkg/errors"
+	"github.com/nscaledev/unicli/pkg/factory"
+	"github.com/nscaledev/unicli/pkg/flags"
+	"github.com/nscaledev/unicli/pkg/util"
+	unikornv1core "github.com/unikorn-cloud/core/pkg/apis/unikorn/v1alpha1"
+	"github.com/unikorn-cloud/core/pkg/constants"
+	coreutil "github.com/unikorn-cloud/core/pkg/util"
+	identityv1 "github.com/unikorn-cloud/identity/pkg/apis/unikorn/v1alpha1"
+	regionv1 "github.com/unikorn-cloud/region/pkg/apis/unikorn/v1alpha1"
+	regionconstants "github.com/unikorn-cloud/region/pkg/constants"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+// servicePrincipalName is what the region service calls the identity that owns
+// a network and all its infrastructure, we use the same so they are
+// indistinguishable from those created via the API.
+const servicePrincipalName = "networkv2-service-principal"
+
+type createNetworkOptions struct {
+	UnikornFlags *factory.UnikornFlags
+
+	organization *flags.OrganizationFlags
+	project      *flags.ProjectFlags
+	region       *flags.RegionFlags
+	wait         *flags.WaitFlags
+
+	name           string
+	description    string
+	prefix         string
+	dnsNameservers []string
+	routes         flags.RoutesFlag
+
+	// parsedPrefix and parsedDNSNameservers are derived from the flags
+	// during validation.
+	parsedPrefix         *net.IPNet
+	parsedDNSNameservers []unikornv1core.IPv4Address
+}
+
+func (o *createNetworkOptions) AddFlags(cmd *cobra.Command, factory *factory.Factory) error {
+	cmd.Flags().StringVar(&o.name, "name", "", "Network name.")
+	cmd.Flags().StringVar(&o.description, "description", "", "A verbose network description.")
+	cmd.Flags().StringVar(&o.prefix, "prefix", "", "Network prefix e.g. 192.168.0.0/24, must be /24 or larger.")
+	cmd.Flags().StringSliceVar(&o.dnsNameservers, "dns", nil, "DNS nameservers for the network, may be specified more than once.")
+	cmd.Flags().Var(&o.routes, "route", "Route in the form prefix=10.0.0.0/8,nexthop=192.168.0.1, may be specified more than once.")
+
+	for _, flag := range []string{"name", "prefix"} {
+		if err := cmd.MarkFlagRequired(flag); err != nil {
+			return err
+		}
+	}
+
+	if err := o.organization.AddFlags(cmd, factory, true); err != nil {
+		return err
+	}
+
+	if err := o.project.AddFlags(cmd, factory, true); err != nil {
+		return err
+	}
+
+	if err := o.region.AddFlags(cmd, factory, true); err != nil {
+		return err
+	}
+
+	o.wait.AddFlags(cmd)
+
+	return nil
+}
+
+// validateAddressing parses the prefix and DNS nameservers, and ensures routes
+// are reachable i.e. the next hop is on the network.
+func (o *createNetworkOptions) validateAddressing(ctx context.Context, cli client.Client) error {
+	ip, prefix, err := net.ParseCIDR(o.prefix)
+	if err != nil || ip.To4() == nil {
+		return fmt.Errorf("%w: prefix %q is not a valid IPv4 prefix", errors.ErrValidation, o.prefix)
+	}
+
+	// This is enforced by the region service, so fail early rather than have
+	// the network never provision.
+	if ones, _ := prefix.Mask.Size(); ones > 24 {
+		return fmt.Errorf("%w: prefix %s must be /24 or larger", errors.ErrValidation, prefix)
+	}
+
+	o.parsedPrefix = prefix
+
+	o.parsedDNSNameservers = make([]unikornv1core.IPv4Address, len(o.dnsNameservers))
+
+	for i, nameserver := range o.dnsNameservers {
+		ip := net.ParseIP(nameserver)
+		if ip == nil || ip.To4() == nil {
+			return fmt.Errorf("%w: DNS nameserver %q is not a valid IPv4 address", errors.ErrValidation, nameserver)
+		}
+
+		o.parsedDNSNameservers[i] = unikornv1core.IPv4Address{IP: ip}
+	}
+
+	for i := range o.routes {
+		route := &o.routes[i]
+
+		if !prefix.Contains(route.NextHop.IP) {
+			return fmt.Errorf("%w: route to %s has next hop %s outside of network prefix %s", errors.ErrValidation, route.Prefix.String(), route.NextHop.String(), prefix)
+		}
+	}
+
+	return nil
+}
+
+// validateNetworks ensures the name isn't already in use in the project, and
+// the prefix doesn't overlap any other network in the project, as they may be
+// attached to the same instances or routed to one another.
+func (o *createNetworkOptions) validateNetworks(ctx context.Context, cli client.Client) error {
+	options := &client.ListOptions{
+		Namespace: o.UnikornFlags.RegionNamespace,
+		LabelSelector: labels.SelectorFromSet(labels.Set{
+			constants.OrganizationLabel: o.organization.Organization.Name,
+			constants.ProjectLabel:      o.project.Project.Name,
+		}),
+	}
+
+	var resources regionv1.NetworkList
+
+	if err := cli.List(ctx, &resources, options); err != nil {
+		return err
+	}
+
+	for i := range resources.Items {
+		network := &resources.Items[i]
+
+		name := network.Labels[constants.NameLabel]
+
+		if name == o.name {
+			return fmt.Errorf("%w: network %s already exists in project %s", errors.ErrValidation, o.name, o.project.ProjectName)
+		}
+
+		if network.Spec.Prefix == nil {
+			continue
+		}
+
+		if util.PrefixesOverlap(o.parsedPrefix, &network.Spec.Prefix.IPNet) {
+			return fmt.Errorf("%w: prefix %s overlaps network %s prefix %s", errors.ErrValidation, o.parsedPrefix, name, network.Spec.Prefix.String())
+		}
+	}
+
+	return nil
+}
+
+func (o *createNetworkOptions) validate(ctx context.Context, cli client.Client) error {
+	validators := []func(context.Context, client.Client) error{
+		o.organization.Validate,
+		o.project.Validate,
+		o.region.Validate,
+		o.validateAddressing,
+		o.validateNetworks,
+	}
+
+	for _, validator := range validators {
+		if err := validator(ctx, cli); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// createServicePrincipal creates the identity that owns the network and all its
+// infrastructure, deleting it cascades to everything else.
+func (o *createNetworkOptions) createServicePrincipal(ctx context.Context, cli client.Client) (*regionv1.Identity, error) {
+	identity := &regionv1.Identity{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: o.UnikornFlags.RegionNamespace,
+			Name:      coreutil.GenerateResourceID(),
+			Labels: map[string]string{
+				constants.OrganizationLabel: o.organization.Organization.Name,
+				constants.ProjectLabel:      o.project.Project.Name,
+				constants.NameLabel:         servicePrincipalName,
+				regionconstants.RegionLabel: o.region.Region.Name,
+			},
+		},
+		Spec: regionv1.IdentitySpec{
+			Provider: o.region.Region.Spec.Provider,
+		},
+	}
+
+	util.SetPrincipal(identity, o.organization.Organization.Name, o.project.Project.Name)
+
+	if err := cli.Create(ctx, identity); err != nil {
+		return nil, err
+	}
+
+	return identity, nil
+}
+
+// cleanup removes anything created ahead of the network, as nothing else will
+// ever clean it up.
+func (o *createNetworkOptions) cleanup(ctx context.Context, cli client.Client, identity *regionv1.Identity, allocation *identityv1.Allocation) error {
+	if allocation != nil {
+		if err := util.DeleteAllocation(ctx, cli, allocation); err != nil {
+			return err
+		}
+	}
+
+	// Like the region service, cascade to anything the identity owns.
+	if err := cli.Delete(ctx, identity, client.PropagationPolicy(metav1.DeletePropagationForeground)); client.IgnoreNotFound(err) != nil {
+		return fmt.Errorf("failed to clean up service principal %s: %w", identity.Name, err)
+	}
+
+	return nil
+}
+
+// waitForOpenstack waits for the network's OpenStack resources to be reported,
+// these are required before anything can be attached to the network.
+func (o *createNetworkOptions) waitForOpenstack(ctx context.Context, cli client.Client, network *regionv1.Network) error {
+	check := func() error {
+		status := network.Status.Openstack
+
+		if status == nil || status.NetworkID == nil || status.SubnetID == nil || status.VlanID == nil {
+			return fmt.Errorf("%w: network %s has not reported its openstack network, subnet and VLAN", errors.ErrResource, o.name)
+		}
+
+		return nil
+	}
+
+	if err := o.wait.WaitFor(ctx, cli, network, check); err != nil {
+		return err
+	}
+
+	if o.wait.Wait {
+		fmt.Printf("network %s has openstack network %s, subnet %s and VLAN %d\n", o.name, *network.Status.Openstack.NetworkID, *network.Status.Openstack.SubnetID, *network.Status.Openstack.VlanID)
+	}
+
+	return nil
+}
+
+func (o *createNetworkOptions) execute(ctx context.Context, cli client.Client) error {
+	identity, err := o.createServicePrincipal(ctx, cli)
+	if err != nil {
+		return err
+	}
+
+	network := &regionv1.Network{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: o.UnikornFlags.RegionNamespace,
+			Name:      coreutil.GenerateResourceID(),
+			Labels: map[string]string{
+				constants.OrganizationLabel:             o.organization.Organization.Name,
+				constants.ProjectLabel:                  o.project.Project.Name,
+				constants.NameLabel:                     o.name,
+				regionconstants.RegionLabel:             o.region.Region.Name,
+				regionconstants.IdentityLabel:           identity.Name,
+				regionconstants.ResourceAPIVersionLabel: regionconstants.MarshalAPIVersion(2),
+			},
+		},
+		Spec: regionv1.NetworkSpec{
+			Prefix: &unikornv1core.IPv4Prefix{
+				IPNet: *o.parsedPrefix,
+			},
+			DNSNameservers: o.parsedDNSNameservers,
+			Routes:         o.routes,
+		},
+	}
+
+	if o.description != "" {
+		network.Annotations = map[string]string{
+			constants.DescriptionAnnotation: o.description,
+		}
+	}
+
+	util.SetPrincipal(network, o.organization.Organization.Name, o.project.Project.Name)
+
+	// Networks are owned by their service principal, and are removed with it.
+	if err := controllerutil.SetOwnerReference(identity, network, cli.Scheme(), controllerutil.WithBlockOwnerDeletion(true)); err != nil {
+		if cerr := o.cleanup(ctx, cli, identity, nil); cerr != nil {
+			return fmt.Errorf("%w: %w", err, cerr)
+		}
+
+		return err
+	}
+
+	// Like the region service, the network is charged against the organization's
+	// quota, and the region controller releases it on deprovisioning.
+	allocation, err := util.CreateAllocation(ctx, cli, o.project.Project.Status.Namespace, network, []identityv1.ResourceAllocation{
+		util.ResourceAllocation("networks", 1, 0),
+	})
+	if err != nil {
+		if cerr := o.cleanup(ctx, cli, identity, nil); cerr != nil {
+			return fmt.Errorf("%w: %w", err, cerr)
+		}
+
+		return err
+	}
+
+	if err := cli.Create(ctx, network); err != nil {
+		if cerr := o.cleanup(ctx, cli, identity, allocation); cerr != nil {
+			return fmt.Errorf("%w: %w", err, cerr)
+		}
+
+		return err
+	}
+
+	if err := o.wait.WaitForProvisioned(ctx, cli, "network", o.name, network); err != nil {
+		return err
+	}
+
+	if err := o.waitForOpenstack(ctx, cli, network); err != nil {
+		return err
+	}
+
+	fmt.Println(network.Name)
+
+	return nil
+}
+
+func Command(factory *factory.Factory) *cobra.Command {
+	unikornFlags := &factory.UnikornFlags
+	organizationFlags := flags.NewOrganizationFlags(unikornFlags)
+	projectFlags := flags.NewProjectFlags(unikornFlags, organizationFlags)
+
+	o := createNetworkOptions{
+		UnikornFlags: unikornFlags,
+		organization: organizationFlags,
+		project:      projectFlags,
+		region:       flags.NewRegionFlags(unikornFlags),
+		wait:         flags.NewWaitFlags(10 * time.Minute),
+	}
+
+	cmd := &cobra.Command{
+		Use:   "network",
+		Short: "Create a network",
+		Long: `Create a network.
+
+Networks are created in a region, for use by compute instances in a project.
+The prefix may not overlap any other network in the project, and route next
+hops must be addresses on the network.  As with the API, each network is
+allocated from the organization's networks quota.  Once provisioned, the
+network's OpenStack network, subnet and VLAN are reported.
+
+Examples:
+  # Create a network with a default route via an appliance
+  unicli create network --organization acme --project web --region eu-west \
+    --name internal --prefix 192.168.0.0/24 --dns 8.8.8.8 \
+    --route prefix=0.0.0.0/0,nexthop=192.168.0.254`,
+		Aliases: []string{
+			"net",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			client, err := factory.Client(ctx)
+			if err != nil {
+				return err
+			}
+
+			if err := o.validate(ctx, client); err != nil {
+				return err
+			}
+
+			if err := o.execute(ctx, client); err != nil {
+				return err
+			}
+
+			return nil
+		},
+	}
+
+	if err := o.AddFlags(cmd, factory); err != nil {
+		panic(err)
+	}
+
+	return cmd
+}
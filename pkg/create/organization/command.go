@@ -114,8 +114,13 @@ func (o *createOrganizationOptions) execute(ctx context.Context, cli client.Clie
 		return err
 	}
 
+	// The namespace is created almost immediately, so if it hasn't appeared
+	// in a reasonable time something is wrong.
+	waitCtx, cancel := context.WithTimeout(ctx, time.Minute)
+	defer cancel()
+
 	callback := func() error {
-		if err := cli.Get(ctx, client.ObjectKey{Namespace: o.UnikornFlags.IdentityNamespace, Name: organizationID}, organization); err != nil {
+		if err := cli.Get(waitCtx, client.ObjectKey{Namespace: o.UnikornFlags.IdentityNamespace, Name: organizationID}, organization); err != nil {
 			return err
 		}
 
@@ -126,7 +131,7 @@ func (o *createOrganizationOptions) execute(ctx context.Context, cli client.Clie
 		return nil
 	}
 
-	if err := retry.Forever().DoWithContext(ctx, callback); err != nil {
+	if err := retry.Forever().DoWithContext(waitCtx, callback); err != nil {
 		return err
 	}
 
@@ -142,10 +147,9 @@ func Command(factory *factory.Factory) *cobra.Command {
 		Use:   "organization",
 		Short: "Create an organization",
 		RunE: func(cmd *cobra.Command, args []string) error {
-			ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
-			defer cancel()
+			ctx := cmd.Context()
 
-			client, err := factory.Client()
+			client, err := factory.Client(ctx)
 			if err != nil {
 				return err
 			}
@@ -0,0 +1,234 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package project
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/nscaledev/unicli/pkg/errors"
+	"github.com/nscaledev/unicli/pkg/factory"
+	"github.com/nscaledev/unicli/pkg/flags"
+	"github.com/nscaledev/unicli/pkg/resolve"
+	"github.com/unikorn-cloud/core/pkg/constants"
+	coreutil "github.com/unikorn-cloud/core/pkg/util"
+	"github.com/unikorn-cloud/core/pkg/util/retry"
+	identityv1 "github.com/unikorn-cloud/identity/pkg/apis/unikorn/v1alpha1"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+type createProjectOptions struct {
+	UnikornFlags *factory.UnikornFlags
+
+	organization *flags.OrganizationFlags
+	name         string
+	description  string
+	groups       []string
+
+	groupIDs []string
+}
+
+func (o *createProjectOptions) AddFlags(cmd *cobra.Command, factory *factory.Factory) error {
+	cmd.Flags().StringVar(&o.name, "name", "", "Project name.")
+	cmd.Flags().StringVar(&o.description, "description", "", "A verbose project description.")
+	cmd.Flags().StringSliceVar(&o.groups, "group", nil, "Groups granted access to the project, may be specified more than once.")
+
+	if err := cmd.MarkFlagRequired("name"); err != nil {
+		return err
+	}
+
+	if err := cmd.RegisterFlagCompletionFunc("group", factory.GroupNameCompletionFunc(&o.organization.OrganizationName)); err != nil {
+		return err
+	}
+
+	if err := o.organization.AddFlags(cmd, factory, true); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateProject ensures the project doesn't already exist in the organization.
+func (o *createProjectOptions) validateProject(ctx context.Context, cli client.Client) error {
+	options := &client.ListOptions{
+		Namespace: o.organization.Organization.Status.Namespace,
+		LabelSelector: labels.SelectorFromSet(labels.Set{
+			constants.OrganizationLabel: o.organization.Organization.Name,
+			constants.NameLabel:         o.name,
+		}),
+	}
+
+	var resources identityv1.ProjectList
+
+	if err := cli.List(ctx, &resources, options); err != nil {
+		return err
+	}
+
+	if len(resources.Items) != 0 {
+		return fmt.Errorf("%w: expected no projects to exist with name %s", errors.ErrValidation, o.name)
+	}
+
+	return nil
+}
+
+// validateGroups ensures the groups exist and sets the IDs for use later.
+func (o *createProjectOptions) validateGroups(ctx context.Context, cli client.Client) error {
+	// Remove duplicates.
+	slices.Sort(o.groups)
+	o.groups = slices.Compact(o.groups)
+
+	scope := &resolve.Scope{
+		IdentityNamespace: o.UnikornFlags.IdentityNamespace,
+		Namespace:         o.organization.Organization.Status.Namespace,
+	}
+
+	o.groupIDs = make([]string, len(o.groups))
+
+	for i, group := range o.groups {
+		resource, err := resolve.Group(ctx, cli, scope, group)
+		if err != nil {
+			return err
+		}
+
+		o.groupIDs[i] = resource.Name
+	}
+
+	return nil
+}
+
+func (o *createProjectOptions) validate(ctx context.Context, cli client.Client) error {
+	validators := []func(context.Context, client.Client) error{
+		o.organization.Validate,
+		o.validateProject,
+		o.validateGroups,
+	}
+
+	for _, validator := range validators {
+		if err := validator(ctx, cli); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (o *createProjectOptions) execute(ctx context.Context, cli client.Client) error {
+	projectID := coreutil.GenerateResourceID()
+
+	project := &identityv1.Project{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: o.organization.Organization.Status.Namespace,
+			Name:      projectID,
+			Labels: map[string]string{
+				constants.OrganizationLabel: o.organization.Organization.Name,
+				constants.NameLabel:         o.name,
+			},
+		},
+		Spec: identityv1.ProjectSpec{
+			GroupIDs: o.groupIDs,
+		},
+	}
+
+	if o.description != "" {
+		project.Annotations = map[string]string{
+			constants.DescriptionAnnotation: o.description,
+		}
+	}
+
+	if err := cli.Create(ctx, project); err != nil {
+		return err
+	}
+
+	// Like organizations, the namespace is created almost immediately, so if
+	// it hasn't appeared in a reasonable time something is wrong.
+	waitCtx, cancel := context.WithTimeout(ctx, time.Minute)
+	defer cancel()
+
+	callback := func() error {
+		if err := cli.Get(waitCtx, client.ObjectKey{Namespace: project.Namespace, Name: projectID}, project); err != nil {
+			return err
+		}
+
+		if project.Status.Namespace == "" {
+			return fmt.Errorf("%w: project not provisioned", errors.ErrResource)
+		}
+
+		return nil
+	}
+
+	if err := retry.Forever().DoWithContext(waitCtx, callback); err != nil {
+		return err
+	}
+
+	fmt.Println(projectID)
+
+	return nil
+}
+
+func Command(factory *factory.Factory) *cobra.Command {
+	unikornFlags := &factory.UnikornFlags
+
+	o := createProjectOptions{
+		UnikornFlags: unikornFlags,
+		organization: flags.NewOrganizationFlags(unikornFlags),
+	}
+
+	cmd := &cobra.Command{
+		Use:   "project",
+		Short: "Create a project",
+		Long: `Create a project in an organization, and wait for it to be provisioned.
+
+Members of the groups specified with --group are granted access to the
+project.  The new project's ID is printed on success.
+
+Examples:
+  # Create a project accessible to the platform team
+  unicli create project --organization acme --name web --group platform`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			client, err := factory.Client(ctx)
+			if err != nil {
+				return err
+			}
+
+			if err := o.validate(ctx, client); err != nil {
+				return err
+			}
+
+			if err := o.execute(ctx, client); err != nil {
+				return err
+			}
+
+			return nil
+		},
+	}
+
+	if err := o.AddFlags(cmd, factory); err != nil {
+		panic(err)
+	}
+
+	return cmd
+}
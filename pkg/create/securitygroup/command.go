@@ -0,0 +1,221 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package securitygroup
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/nscaledev/unicli/pkg/errors"
+	"github.com/nscaledev/unicli/pkg/factory"
+	"github.com/nscaledev/unicli/pkg/flags"
+	"github.com/unikorn-cloud/core/pkg/constants"
+	coreutil "github.com/unikorn-cloud/core/pkg/util"
+	regionv1 "github.com/unikorn-cloud/region/pkg/apis/unikorn/v1alpha1"
+	regionconstants "github.com/unikorn-cloud/region/pkg/constants"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+type createSecurityGroupOptions struct {
+	UnikornFlags *factory.UnikornFlags
+
+	organization *flags.OrganizationFlags
+	project      *flags.ProjectFlags
+	network      *flags.NetworkFlags
+	wait         *flags.WaitFlags
+
+	name        string
+	description string
+	rules       flags.SecurityGroupRulesFlag
+}
+
+func (o *createSecurityGroupOptions) AddFlags(cmd *cobra.Command, factory *factory.Factory) error {
+	cmd.Flags().StringVar(&o.name, "name", "", "Security group name.")
+	cmd.Flags().StringVar(&o.description, "description", "", "A verbose security group description.")
+	cmd.Flags().Var(&o.rules, "rule", "Rule in the form direction=ingress,protocol=tcp,port=22,cidr=0.0.0.0/0, may be specified more than once.")
+
+	if err := cmd.MarkFlagRequired("name"); err != nil {
+		return err
+	}
+
+	if err := o.organization.AddFlags(cmd, factory, true); err != nil {
+		return err
+	}
+
+	if err := o.project.AddFlags(cmd, factory, true); err != nil {
+		return err
+	}
+
+	if err := o.network.AddFlags(cmd, factory, true); err != nil {
+		return err
+	}
+
+	o.wait.AddFlags(cmd)
+
+	return nil
+}
+
+// validateName ensures the name isn't already in use on the network, as that
+// would make it ambiguous when applying it to instances.
+func (o *createSecurityGroupOptions) validateName(ctx context.Context, cli client.Client) error {
+	options := &client.ListOptions{
+		Namespace: o.UnikornFlags.RegionNamespace,
+		LabelSelector: labels.SelectorFromSet(labels.Set{
+			constants.NameLabel:          o.name,
+			regionconstants.NetworkLabel: o.network.Network.Name,
+		}),
+	}
+
+	var resources regionv1.SecurityGroupList
+
+	if err := cli.List(ctx, &resources, options); err != nil {
+		return err
+	}
+
+	if len(resources.Items) != 0 {
+		return fmt.Errorf("%w: security group %s already exists on network %s", errors.ErrValidation, o.name, o.network.NetworkName)
+	}
+
+	return nil
+}
+
+func (o *createSecurityGroupOptions) validate(ctx context.Context, cli client.Client) error {
+	validators := []func(context.Context, client.Client) error{
+		o.organization.Validate,
+		o.project.Validate,
+		o.network.Validate,
+		o.validateName,
+	}
+
+	for _, validator := range validators {
+		if err := validator(ctx, cli); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (o *createSecurityGroupOptions) execute(ctx context.Context, cli client.Client) error {
+	network := o.network.Network
+
+	securityGroup := &regionv1.SecurityGroup{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: o.UnikornFlags.RegionNamespace,
+			Name:      coreutil.GenerateResourceID(),
+			Labels: map[string]string{
+				constants.OrganizationLabel:             o.organization.Organization.Name,
+				constants.ProjectLabel:                  o.project.Project.Name,
+				constants.NameLabel:                     o.name,
+				regionconstants.RegionLabel:             network.Labels[regionconstants.RegionLabel],
+				regionconstants.IdentityLabel:           network.Labels[regionconstants.IdentityLabel],
+				regionconstants.NetworkLabel:            network.Name,
+				regionconstants.ResourceAPIVersionLabel: regionconstants.MarshalAPIVersion(2),
+			},
+		},
+		Spec: regionv1.SecurityGroupSpec{
+			Rules: o.rules,
+		},
+	}
+
+	if o.description != "" {
+		securityGroup.Annotations = map[string]string{
+			constants.DescriptionAnnotation: o.description,
+		}
+	}
+
+	// Security groups are owned by the network, and are removed with it.
+	if err := controllerutil.SetOwnerReference(network, securityGroup, cli.Scheme(), controllerutil.WithBlockOwnerDeletion(true)); err != nil {
+		return err
+	}
+
+	if err := cli.Create(ctx, securityGroup); err != nil {
+		return err
+	}
+
+	if err := o.wait.WaitForProvisioned(ctx, cli, "security group", o.name, securityGroup); err != nil {
+		return err
+	}
+
+	fmt.Println(securityGroup.Name)
+
+	return nil
+}
+
+func Command(factory *factory.Factory) *cobra.Command {
+	unikornFlags := &factory.UnikornFlags
+	organizationFlags := flags.NewOrganizationFlags(unikornFlags)
+	projectFlags := flags.NewProjectFlags(unikornFlags, organizationFlags)
+
+	o := createSecurityGroupOptions{
+		UnikornFlags: unikornFlags,
+		organization: organizationFlags,
+		project:      projectFlags,
+		network:      flags.NewNetworkFlags(unikornFlags, organizationFlags, projectFlags),
+		wait:         flags.NewWaitFlags(5 * time.Minute),
+	}
+
+	cmd := &cobra.Command{
+		Use:   "securitygroup",
+		Short: "Create a security group",
+		Long: `Create a security group.
+
+Security groups belong to a network, and may be applied to compute instances
+on that network.  Rules default to ingress, and omitting the port or CIDR
+leaves them unrestricted.
+
+Examples:
+  # Allow SSH and HTTPS from anywhere
+  unicli create securitygroup --organization acme --project web --network default --name public \
+    --rule protocol=tcp,port=22 --rule protocol=tcp,port=443,cidr=0.0.0.0/0`,
+		Aliases: []string{
+			"sg",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			client, err := factory.Client(ctx)
+			if err != nil {
+				return err
+			}
+
+			if err := o.validate(ctx, client); err != nil {
+				return err
+			}
+
+			if err := o.execute(ctx, client); err != nil {
+				return err
+			}
+
+			return nil
+		},
+	}
+
+	if err := o.AddFlags(cmd, factory); err != nil {
+		panic(err)
+	}
+
+	return cmd
+}
@@ -18,45 +18,84 @@ package user
 
 import (
 	"context"
+	goerrors "errors"
 	"fmt"
 	"slices"
-	"time"
+	"strings"
 
 	"github.com/spf13/cobra"
 
 	"github.com/nscaledev/unicli/pkg/errors"
 	"github.com/nscaledev/unicli/pkg/factory"
 	"github.com/nscaledev/unicli/pkg/flags"
+	"github.com/nscaledev/unicli/pkg/resolve"
+	"github.com/nscaledev/unicli/pkg/util"
 	"github.com/unikorn-cloud/core/pkg/constants"
 	coreutil "github.com/unikorn-cloud/core/pkg/util"
 	identityv1 "github.com/unikorn-cloud/identity/pkg/apis/unikorn/v1alpha1"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
+// states are the valid organization user states.
+var states = []string{
+	string(identityv1.UserStatePending),
+	string(identityv1.UserStateActive),
+	string(identityv1.UserStateSuspended),
+}
+
 type createUserOptions struct {
 	UnikornFlags *factory.UnikornFlags
 
 	email        string
 	organization *flags.OrganizationFlags
+	groups       []string
+	state        string
+
+	// user is the existing global user, if one exists.
+	user *identityv1.User
+	// groupResources are the groups the user will be added to.
+	groupResources []*identityv1.Group
 }
 
 func (o *createUserOptions) AddFlags(cmd *cobra.Command, factory *factory.Factory) error {
 	cmd.Flags().StringVar(&o.email, "email", "", "User's email address.")
+	cmd.Flags().StringSliceVar(&o.groups, "group", nil, "Groups to add the user to, may be specified more than once.")
+	cmd.Flags().StringVar(&o.state, "state", string(identityv1.UserStateActive), fmt.Sprintf("User's state in the organization. One of: %s", strings.Join(states, ", ")))
 
 	if err := cmd.MarkFlagRequired("email"); err != nil {
 		return err
 	}
 
-	if err := o.organization.AddFlags(cmd, factory, false); err != nil {
+	if err := cmd.RegisterFlagCompletionFunc("group", factory.GroupNameCompletionFunc(&o.organization.OrganizationName)); err != nil {
+		return err
+	}
+
+	if err := cmd.RegisterFlagCompletionFunc("state", cobra.FixedCompletions(states, cobra.ShellCompDirectiveNoFileComp)); err != nil {
+		return err
+	}
+
+	if err := o.organization.AddFlags(cmd, factory, true); err != nil {
 		return err
 	}
 
 	return nil
 }
 
+func (o *createUserOptions) validateState(ctx context.Context, cli client.Client) error {
+	if !slices.Contains(states, o.state) {
+		return fmt.Errorf("%w: unsupported state %q, expected one of: %s", errors.ErrValidation, o.state, strings.Join(states, ", "))
+	}
+
+	return nil
+}
+
+// validateUser finds any existing global user, which is reused, users are
+// unique across the system and can be members of many organizations.  The user
+// must not already be a member of the organization.
 func (o *createUserOptions) validateUser(ctx context.Context, cli client.Client) error {
 	resources := &identityv1.UserList{}
 
@@ -68,8 +107,54 @@ func (o *createUserOptions) validateUser(ctx context.Context, cli client.Client)
 		return user.Spec.Subject == o.email
 	}
 
-	if ok := slices.ContainsFunc(resources.Items, matchesEmail); ok {
-		return fmt.Errorf("%w: user already exists", errors.ErrValidation)
+	index := slices.IndexFunc(resources.Items, matchesEmail)
+	if index < 0 {
+		return nil
+	}
+
+	o.user = &resources.Items[index]
+
+	options := &client.ListOptions{
+		Namespace: o.organization.Organization.Status.Namespace,
+		LabelSelector: labels.SelectorFromSet(labels.Set{
+			constants.OrganizationLabel: o.organization.Organization.Name,
+			constants.UserLabel:         o.user.Name,
+		}),
+	}
+
+	organizationUsers := &identityv1.OrganizationUserList{}
+
+	if err := cli.List(ctx, organizationUsers, options); err != nil {
+		return err
+	}
+
+	if len(organizationUsers.Items) != 0 {
+		return fmt.Errorf("%w: user %s is already a member of organization %s", errors.ErrValidation, o.email, o.organization.OrganizationName)
+	}
+
+	return nil
+}
+
+// validateGroups ensures the groups exist in the organization.
+func (o *createUserOptions) validateGroups(ctx context.Context, cli client.Client) error {
+	// Remove duplicates.
+	slices.Sort(o.groups)
+	o.groups = slices.Compact(o.groups)
+
+	scope := &resolve.Scope{
+		IdentityNamespace: o.UnikornFlags.IdentityNamespace,
+		Namespace:         o.organization.Organization.Status.Namespace,
+	}
+
+	o.groupResources = make([]*identityv1.Group, len(o.groups))
+
+	for i, group := range o.groups {
+		resource, err := resolve.Group(ctx, cli, scope, group)
+		if err != nil {
+			return err
+		}
+
+		o.groupResources[i] = resource
 	}
 
 	return nil
@@ -77,8 +162,10 @@ func (o *createUserOptions) validateUser(ctx context.Context, cli client.Client)
 
 func (o *createUserOptions) validate(ctx context.Context, cli client.Client) error {
 	validators := []func(context.Context, client.Client) error{
-		o.validateUser,
 		o.organization.Validate,
+		o.validateState,
+		o.validateUser,
+		o.validateGroups,
 	}
 
 	for _, validator := range validators {
@@ -90,7 +177,12 @@ func (o *createUserOptions) validate(ctx context.Context, cli client.Client) err
 	return nil
 }
 
-func (o *createUserOptions) execute(ctx context.Context, cli client.Client) error {
+// createUser creates the global user if it doesn't already exist.
+func (o *createUserOptions) createUser(ctx context.Context, cli client.Client) (*identityv1.User, error) {
+	if o.user != nil {
+		return o.user, nil
+	}
+
 	user := &identityv1.User{
 		ObjectMeta: metav1.ObjectMeta{
 			Namespace: o.UnikornFlags.IdentityNamespace,
@@ -106,9 +198,91 @@ func (o *createUserOptions) execute(ctx context.Context, cli client.Client) erro
 	}
 
 	if err := cli.Create(ctx, user); err != nil {
+		return nil, err
+	}
+
+	return user, nil
+}
+
+// addToGroups adds the organization user to the requested groups, groups refer
+// to organization users, not global ones.  The groups the user was added to are
+// returned, even on error, so they can be undone.
+func (o *createUserOptions) addToGroups(ctx context.Context, cli client.Client, organizationUser *identityv1.OrganizationUser) ([]*identityv1.Group, error) {
+	var added []*identityv1.Group
+
+	for _, group := range o.groupResources {
+		update := func(group *identityv1.Group) {
+			util.AddGroupMember(group, organizationUser.Name, o.email)
+		}
+
+		if err := util.UpdateGroup(ctx, cli, group, update); err != nil {
+			return added, fmt.Errorf("failed to add user to group %s: %w", group.Labels[constants.NameLabel], err)
+		}
+
+		added = append(added, group)
+	}
+
+	return added, nil
+}
+
+// cleanup removes the organization user, and any group memberships, when the
+// user cannot be fully enrolled, so the command can simply be run again.  The
+// global user is left behind, it will be reused.
+func (o *createUserOptions) cleanup(ctx context.Context, cli client.Client, organizationUser *identityv1.OrganizationUser, groups []*identityv1.Group) error {
+	var errs []error
+
+	for _, group := range groups {
+		update := func(group *identityv1.Group) {
+			util.RemoveGroupMember(group, organizationUser.Name, o.email)
+		}
+
+		if err := util.UpdateGroup(ctx, cli, group, update); err != nil {
+			errs = append(errs, fmt.Errorf("failed to remove user from group %s: %w", group.Labels[constants.NameLabel], err))
+		}
+	}
+
+	if err := cli.Delete(ctx, organizationUser); client.IgnoreNotFound(err) != nil {
+		errs = append(errs, fmt.Errorf("failed to clean up organization user %s: %w", organizationUser.Name, err))
+	}
+
+	return goerrors.Join(errs...)
+}
+
+func (o *createUserOptions) execute(ctx context.Context, cli client.Client) error {
+	user, err := o.createUser(ctx, cli)
+	if err != nil {
 		return err
 	}
 
+	organizationUser := &identityv1.OrganizationUser{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: o.organization.Organization.Status.Namespace,
+			Name:      coreutil.GenerateResourceID(),
+			Labels: map[string]string{
+				constants.OrganizationLabel: o.organization.Organization.Name,
+				constants.UserLabel:         user.Name,
+				constants.NameLabel:         constants.UndefinedName,
+			},
+		},
+		Spec: identityv1.OrganizationUserSpec{
+			State: identityv1.UserState(o.state),
+		},
+	}
+
+	if err := cli.Create(ctx, organizationUser); err != nil {
+		return err
+	}
+
+	if groups, err := o.addToGroups(ctx, cli, organizationUser); err != nil {
+		if cerr := o.cleanup(ctx, cli, organizationUser, groups); cerr != nil {
+			return fmt.Errorf("%w: %w", err, cerr)
+		}
+
+		return err
+	}
+
+	fmt.Println(organizationUser.Name)
+
 	return nil
 }
 
@@ -123,11 +297,24 @@ func Command(factory *factory.Factory) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "user",
 		Short: "Create a user",
+		Long: `Create a user in an organization.
+
+Users are unique across the system, so if a user with the email address
+already exists it's reused, and enrolled in the organization.  The user is
+added to any groups specified with --group, if that fails the enrolment is
+undone so the command can simply be run again.  The new organization user's ID
+is printed on success.
+
+Examples:
+  # Invite a user to an organization, they must complete onboarding first
+  unicli create user --organization acme --email jo@acme.com --state pending
+
+  # Add a user to an organization as a member of the platform team
+  unicli create user --organization acme --email jo@acme.com --group platform`,
 		RunE: func(cmd *cobra.Command, args []string) error {
-			ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
-			defer cancel()
+			ctx := cmd.Context()
 
-			client, err := factory.Client()
+			client, err := factory.Client(ctx)
 			if err != nil {
 				return err
 			}
@@ -0,0 +1,145 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clustermanager
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/nscaledev/unicli/pkg/factory"
+	"github.com/nscaledev/unicli/pkg/flags"
+	"github.com/nscaledev/unicli/pkg/resolve"
+	"github.com/unikorn-cloud/core/pkg/constants"
+	kubernetesv1 "github.com/unikorn-cloud/kubernetes/pkg/apis/unikorn/v1alpha1"
+
+	"k8s.io/apimachinery/pkg/labels"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+type options struct {
+	UnikornFlags *factory.UnikornFlags
+
+	organization *flags.OrganizationFlags
+	delete       *flags.DeleteFlags
+}
+
+func (o *options) AddFlags(cmd *cobra.Command, factory *factory.Factory) error {
+	if err := o.organization.AddFlags(cmd, factory, false); err != nil {
+		return err
+	}
+
+	o.delete.AddFlags(cmd)
+
+	return nil
+}
+
+func (o *options) validate(ctx context.Context, cli client.Client) error {
+	validators := []func(context.Context, client.Client) error{
+		o.organization.Validate,
+	}
+
+	for _, validator := range validators {
+		if err := validator(ctx, cli); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (o *options) execute(ctx context.Context, cli client.Client, identifier string) error {
+	manager, err := resolve.ClusterManager(ctx, cli, flags.Scope(o.UnikornFlags, o.organization, nil), identifier)
+	if err != nil {
+		return err
+	}
+
+	// Any clusters still managed by this will be orphaned.
+	clusters := &kubernetesv1.KubernetesClusterList{}
+	options := &client.ListOptions{
+		LabelSelector: labels.SelectorFromSet(labels.Set{
+			constants.OrganizationLabel: manager.Labels[constants.OrganizationLabel],
+		}),
+	}
+
+	if err := cli.List(ctx, clusters, options); err != nil {
+		return fmt.Errorf("failed to list kubernetes clusters: %w", err)
+	}
+
+	var dependents []string
+
+	for i := range clusters.Items {
+		cluster := &clusters.Items[i]
+
+		if cluster.Spec.ClusterManagerID == manager.Name {
+			dependents = append(dependents, fmt.Sprintf("kubernetescluster/%s (%s)", cluster.Labels[constants.NameLabel], cluster.Name))
+		}
+	}
+
+	name := manager.Labels[constants.NameLabel]
+
+	if err := o.delete.Confirm("cluster manager", name, manager.Name, dependents); err != nil {
+		return err
+	}
+
+	return o.delete.Delete(ctx, cli, "cluster manager", name, manager)
+}
+
+func Command(factory *factory.Factory) *cobra.Command {
+	unikornFlags := &factory.UnikornFlags
+
+	o := options{
+		UnikornFlags: unikornFlags,
+		organization: flags.NewOrganizationFlags(unikornFlags),
+		delete:       flags.NewDeleteFlags(),
+	}
+
+	cmd := &cobra.Command{
+		Use:   "clustermanager <name|id>",
+		Short: "Delete a kubernetes cluster manager",
+		Aliases: []string{
+			"cm",
+		},
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			client, err := factory.Client(ctx)
+			if err != nil {
+				return err
+			}
+
+			if err := o.validate(ctx, client); err != nil {
+				return err
+			}
+
+			if err := o.execute(ctx, client, args[0]); err != nil {
+				return err
+			}
+
+			return nil
+		},
+	}
+
+	if err := o.AddFlags(cmd, factory); err != nil {
+		panic(err)
+	}
+
+	return cmd
+}
@@ -0,0 +1,53 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package delete
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/nscaledev/unicli/pkg/delete/clustermanager"
+	"github.com/nscaledev/unicli/pkg/delete/computeinstance"
+	"github.com/nscaledev/unicli/pkg/delete/group"
+	"github.com/nscaledev/unicli/pkg/delete/kubernetescluster"
+	"github.com/nscaledev/unicli/pkg/delete/network"
+	"github.com/nscaledev/unicli/pkg/delete/organization"
+	"github.com/nscaledev/unicli/pkg/delete/securitygroup"
+	"github.com/nscaledev/unicli/pkg/delete/user"
+	"github.com/nscaledev/unicli/pkg/delete/virtualkubernetescluster"
+	"github.com/nscaledev/unicli/pkg/factory"
+)
+
+func Command(factory *factory.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delete",
+		Short: "Delete a resource",
+	}
+
+	cmd.AddCommand(
+		clustermanager.Command(factory),
+		computeinstance.Command(factory),
+		group.Command(factory),
+		kubernetescluster.Command(factory),
+		network.Command(factory),
+		organization.Command(factory),
+		securitygroup.Command(factory),
+		user.Command(factory),
+		virtualkubernetescluster.Command(factory),
+	)
+
+	return cmd
+}
@@ -0,0 +1,135 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package computeinstance
+
+import (
+	"context"
+
+	"github.com/spf13/cobra"
+
+	"github.com/nscaledev/unicli/pkg/factory"
+	"github.com/nscaledev/unicli/pkg/flags"
+	"github.com/nscaledev/unicli/pkg/resolve"
+	"github.com/unikorn-cloud/core/pkg/constants"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+type options struct {
+	UnikornFlags *factory.UnikornFlags
+
+	organization *flags.OrganizationFlags
+	project      *flags.ProjectFlags
+	delete       *flags.DeleteFlags
+}
+
+func (o *options) AddFlags(cmd *cobra.Command, factory *factory.Factory) error {
+	if err := o.organization.AddFlags(cmd, factory, false); err != nil {
+		return err
+	}
+
+	if err := o.project.AddFlags(cmd, factory, false); err != nil {
+		return err
+	}
+
+	o.delete.AddFlags(cmd)
+
+	return nil
+}
+
+func (o *options) validate(ctx context.Context, cli client.Client) error {
+	validators := []func(context.Context, client.Client) error{
+		o.organization.Validate,
+		o.project.Validate,
+	}
+
+	for _, validator := range validators {
+		if err := validator(ctx, cli); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (o *options) execute(ctx context.Context, cli client.Client, identifier string) error {
+	instance, err := resolve.ComputeInstance(ctx, cli, flags.Scope(o.UnikornFlags, o.organization, o.project), identifier)
+	if err != nil {
+		return err
+	}
+
+	var dependents []string
+
+	if instance.Status.PublicIP != nil {
+		dependents = append(dependents, "publicip/"+*instance.Status.PublicIP)
+	}
+
+	name := instance.Labels[constants.NameLabel]
+
+	if err := o.delete.Confirm("compute instance", name, instance.Name, dependents); err != nil {
+		return err
+	}
+
+	return o.delete.Delete(ctx, cli, "compute instance", name, instance)
+}
+
+func Command(factory *factory.Factory) *cobra.Command {
+	unikornFlags := &factory.UnikornFlags
+	organizationFlags := flags.NewOrganizationFlags(unikornFlags)
+	projectFlags := flags.NewProjectFlags(unikornFlags, organizationFlags)
+
+	o := options{
+		UnikornFlags: unikornFlags,
+		organization: organizationFlags,
+		project:      projectFlags,
+		delete:       flags.NewDeleteFlags(),
+	}
+
+	cmd := &cobra.Command{
+		Use:   "instance <name|id>",
+		Short: "Delete a compute instance",
+		Aliases: []string{
+			"computeinstance",
+			"ci",
+		},
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			client, err := factory.Client(ctx)
+			if err != nil {
+				return err
+			}
+
+			if err := o.validate(ctx, client); err != nil {
+				return err
+			}
+
+			if err := o.execute(ctx, client, args[0]); err != nil {
+				return err
+			}
+
+			return nil
+		},
+	}
+
+	if err := o.AddFlags(cmd, factory); err != nil {
+		panic(err)
+	}
+
+	return cmd
+}
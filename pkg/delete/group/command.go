@@ -0,0 +1,142 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package group
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/spf13/cobra"
+
+	"github.com/nscaledev/unicli/pkg/factory"
+	"github.com/nscaledev/unicli/pkg/flags"
+	"github.com/nscaledev/unicli/pkg/resolve"
+	"github.com/unikorn-cloud/core/pkg/constants"
+	identityv1 "github.com/unikorn-cloud/identity/pkg/apis/unikorn/v1alpha1"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+type options struct {
+	UnikornFlags *factory.UnikornFlags
+
+	organization *flags.OrganizationFlags
+	delete       *flags.DeleteFlags
+}
+
+func (o *options) AddFlags(cmd *cobra.Command, factory *factory.Factory) error {
+	if err := o.organization.AddFlags(cmd, factory, true); err != nil {
+		return err
+	}
+
+	o.delete.AddFlags(cmd)
+
+	return nil
+}
+
+func (o *options) validate(ctx context.Context, cli client.Client) error {
+	validators := []func(context.Context, client.Client) error{
+		o.organization.Validate,
+	}
+
+	for _, validator := range validators {
+		if err := validator(ctx, cli); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (o *options) execute(ctx context.Context, cli client.Client, identifier string) error {
+	namespace := o.organization.Organization.Status.Namespace
+
+	scope := &resolve.Scope{
+		IdentityNamespace: o.UnikornFlags.IdentityNamespace,
+		Namespace:         namespace,
+	}
+
+	group, err := resolve.Group(ctx, cli, scope, identifier)
+	if err != nil {
+		return err
+	}
+
+	// Projects bound to this group will lose access for its members.
+	projects := &identityv1.ProjectList{}
+	if err := cli.List(ctx, projects, &client.ListOptions{Namespace: namespace}); err != nil {
+		return fmt.Errorf("failed to list projects: %w", err)
+	}
+
+	var dependents []string
+
+	for i := range projects.Items {
+		project := &projects.Items[i]
+
+		if slices.Contains(project.Spec.GroupIDs, group.Name) {
+			dependents = append(dependents, fmt.Sprintf("project/%s (%s)", project.Labels[constants.NameLabel], project.Name))
+		}
+	}
+
+	name := group.Labels[constants.NameLabel]
+
+	if err := o.delete.Confirm("group", name, group.Name, dependents); err != nil {
+		return err
+	}
+
+	return o.delete.Delete(ctx, cli, "group", name, group)
+}
+
+func Command(factory *factory.Factory) *cobra.Command {
+	unikornFlags := &factory.UnikornFlags
+
+	o := options{
+		UnikornFlags: unikornFlags,
+		organization: flags.NewOrganizationFlags(unikornFlags),
+		delete:       flags.NewDeleteFlags(),
+	}
+
+	cmd := &cobra.Command{
+		Use:   "group <name|id>",
+		Short: "Delete a group",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			client, err := factory.Client(ctx)
+			if err != nil {
+				return err
+			}
+
+			if err := o.validate(ctx, client); err != nil {
+				return err
+			}
+
+			if err := o.execute(ctx, client, args[0]); err != nil {
+				return err
+			}
+
+			return nil
+		},
+	}
+
+	if err := o.AddFlags(cmd, factory); err != nil {
+		panic(err)
+	}
+
+	return cmd
+}
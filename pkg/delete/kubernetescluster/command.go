@@ -0,0 +1,153 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetescluster
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/nscaledev/unicli/pkg/factory"
+	"github.com/nscaledev/unicli/pkg/flags"
+	"github.com/nscaledev/unicli/pkg/resolve"
+	"github.com/unikorn-cloud/core/pkg/constants"
+	regionv1 "github.com/unikorn-cloud/region/pkg/apis/unikorn/v1alpha1"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+type options struct {
+	UnikornFlags *factory.UnikornFlags
+
+	organization *flags.OrganizationFlags
+	project      *flags.ProjectFlags
+	delete       *flags.DeleteFlags
+}
+
+func (o *options) AddFlags(cmd *cobra.Command, factory *factory.Factory) error {
+	if err := o.organization.AddFlags(cmd, factory, false); err != nil {
+		return err
+	}
+
+	if err := o.project.AddFlags(cmd, factory, false); err != nil {
+		return err
+	}
+
+	o.delete.AddFlags(cmd)
+
+	return nil
+}
+
+func (o *options) validate(ctx context.Context, cli client.Client) error {
+	validators := []func(context.Context, client.Client) error{
+		o.organization.Validate,
+		o.project.Validate,
+	}
+
+	for _, validator := range validators {
+		if err := validator(ctx, cli); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (o *options) execute(ctx context.Context, cli client.Client, identifier string) error {
+	cluster, err := resolve.KubernetesCluster(ctx, cli, flags.Scope(o.UnikornFlags, o.organization, o.project), identifier)
+	if err != nil {
+		return err
+	}
+
+	// The region service provisions a cloud identity per cluster, this will be
+	// torn down along with the cluster.
+	identities := &regionv1.OpenstackIdentityList{}
+	if err := cli.List(ctx, identities, &client.ListOptions{Namespace: o.UnikornFlags.RegionNamespace}); err != nil {
+		return fmt.Errorf("failed to list OpenStack identities: %w", err)
+	}
+
+	var dependents []string
+
+	for _, pool := range cluster.Spec.WorkloadPools.Pools {
+		dependents = append(dependents, fmt.Sprintf("workloadpool/%s (%d replicas)", pool.Name, pool.Replicas))
+	}
+
+	for i := range identities.Items {
+		identity := &identities.Items[i]
+
+		if strings.TrimPrefix(identity.Labels[constants.NameLabel], "kubernetes-cluster-") == cluster.Name {
+			dependents = append(dependents, "openstackidentity/"+identity.Name)
+		}
+	}
+
+	name := cluster.Labels[constants.NameLabel]
+
+	if err := o.delete.Confirm("kubernetes cluster", name, cluster.Name, dependents); err != nil {
+		return err
+	}
+
+	return o.delete.Delete(ctx, cli, "kubernetes cluster", name, cluster)
+}
+
+func Command(factory *factory.Factory) *cobra.Command {
+	unikornFlags := &factory.UnikornFlags
+	organizationFlags := flags.NewOrganizationFlags(unikornFlags)
+	projectFlags := flags.NewProjectFlags(unikornFlags, organizationFlags)
+
+	o := options{
+		UnikornFlags: unikornFlags,
+		organization: organizationFlags,
+		project:      projectFlags,
+		delete:       flags.NewDeleteFlags(),
+	}
+
+	cmd := &cobra.Command{
+		Use:   "kubernetescluster <name|id>",
+		Short: "Delete a kubernetes cluster",
+		Aliases: []string{
+			"kc",
+		},
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: factory.KubernetesClusterNameCompletionFunc(&organizationFlags.OrganizationName, &projectFlags.ProjectName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			client, err := factory.Client(ctx)
+			if err != nil {
+				return err
+			}
+
+			if err := o.validate(ctx, client); err != nil {
+				return err
+			}
+
+			if err := o.execute(ctx, client, args[0]); err != nil {
+				return err
+			}
+
+			return nil
+		},
+	}
+
+	if err := o.AddFlags(cmd, factory); err != nil {
+		panic(err)
+	}
+
+	return cmd
+}
@@ -0,0 +1,202 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package network
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/nscaledev/unicli/pkg/factory"
+	"github.com/nscaledev/unicli/pkg/flags"
+	"github.com/nscaledev/unicli/pkg/resolve"
+	computev1 "github.com/unikorn-cloud/compute/pkg/apis/unikorn/v1alpha1"
+	"github.com/unikorn-cloud/core/pkg/constants"
+	regionv1 "github.com/unikorn-cloud/region/pkg/apis/unikorn/v1alpha1"
+	regionconstants "github.com/unikorn-cloud/region/pkg/constants"
+
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+type options struct {
+	UnikornFlags *factory.UnikornFlags
+
+	organization *flags.OrganizationFlags
+	project      *flags.ProjectFlags
+	delete       *flags.DeleteFlags
+}
+
+func (o *options) AddFlags(cmd *cobra.Command, factory *factory.Factory) error {
+	if err := o.organization.AddFlags(cmd, factory, false); err != nil {
+		return err
+	}
+
+	if err := o.project.AddFlags(cmd, factory, false); err != nil {
+		return err
+	}
+
+	o.delete.AddFlags(cmd)
+
+	return nil
+}
+
+func (o *options) validate(ctx context.Context, cli client.Client) error {
+	validators := []func(context.Context, client.Client) error{
+		o.organization.Validate,
+		o.project.Validate,
+	}
+
+	for _, validator := range validators {
+		if err := validator(ctx, cli); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (o *options) execute(ctx context.Context, cli client.Client, identifier string) error {
+	network, err := resolve.Network(ctx, cli, flags.Scope(o.UnikornFlags, o.organization, o.project), identifier)
+	if err != nil {
+		return err
+	}
+
+	// Compute instances are attached to a network, and will lose connectivity.
+	instances := &computev1.ComputeInstanceList{}
+
+	options := &client.ListOptions{
+		LabelSelector: labels.SelectorFromSet(labels.Set{
+			regionconstants.NetworkLabel: network.Name,
+		}),
+	}
+
+	if err := cli.List(ctx, instances, options); err != nil {
+		return fmt.Errorf("failed to list compute instances: %w", err)
+	}
+
+	// Security groups are owned by the network, and will be deleted with it.
+	securityGroups := &regionv1.SecurityGroupList{}
+
+	if err := cli.List(ctx, securityGroups, options); err != nil {
+		return fmt.Errorf("failed to list security groups: %w", err)
+	}
+
+	dependents := make([]string, 0, len(instances.Items)+len(securityGroups.Items))
+
+	for i := range instances.Items {
+		dependents = append(dependents, fmt.Sprintf("instance/%s (%s)", instances.Items[i].Labels[constants.NameLabel], instances.Items[i].Name))
+	}
+
+	for i := range securityGroups.Items {
+		dependents = append(dependents, fmt.Sprintf("securitygroup/%s (%s)", securityGroups.Items[i].Labels[constants.NameLabel], securityGroups.Items[i].Name))
+	}
+
+	name := network.Labels[constants.NameLabel]
+
+	if err := o.delete.Confirm("network", name, network.Name, dependents); err != nil {
+		return err
+	}
+
+	servicePrincipal, err := o.servicePrincipal(ctx, cli, network)
+	if err != nil {
+		return err
+	}
+
+	if servicePrincipal == nil {
+		return o.delete.Delete(ctx, cli, "network", name, network)
+	}
+
+	// Like the region service, delete the root of the tree and let cascading
+	// deletion take care of the network and its infrastructure.
+	return o.delete.Delete(ctx, cli, "network", name, servicePrincipal, client.PropagationPolicy(metav1.DeletePropagationForeground))
+}
+
+// servicePrincipal returns the identity that owns a V2 network, V1 networks are
+// children of a user managed identity, so have no service principal.
+func (o *options) servicePrincipal(ctx context.Context, cli client.Client, network *regionv1.Network) (*regionv1.Identity, error) {
+	if network.Labels[regionconstants.ResourceAPIVersionLabel] != regionconstants.MarshalAPIVersion(2) {
+		return nil, nil
+	}
+
+	identityID, ok := network.Labels[regionconstants.IdentityLabel]
+	if !ok {
+		return nil, nil
+	}
+
+	identity := &regionv1.Identity{}
+
+	if err := cli.Get(ctx, client.ObjectKey{Namespace: network.Namespace, Name: identityID}, identity); err != nil {
+		if kerrors.IsNotFound(err) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return identity, nil
+}
+
+func Command(factory *factory.Factory) *cobra.Command {
+	unikornFlags := &factory.UnikornFlags
+	organizationFlags := flags.NewOrganizationFlags(unikornFlags)
+	projectFlags := flags.NewProjectFlags(unikornFlags, organizationFlags)
+
+	o := options{
+		UnikornFlags: unikornFlags,
+		organization: organizationFlags,
+		project:      projectFlags,
+		delete:       flags.NewDeleteFlags(),
+	}
+
+	cmd := &cobra.Command{
+		Use:   "network <name|id>",
+		Short: "Delete a network",
+		Aliases: []string{
+			"net",
+		},
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			client, err := factory.Client(ctx)
+			if err != nil {
+				return err
+			}
+
+			if err := o.validate(ctx, client); err != nil {
+				return err
+			}
+
+			if err := o.execute(ctx, client, args[0]); err != nil {
+				return err
+			}
+
+			return nil
+		},
+	}
+
+	if err := o.AddFlags(cmd, factory); err != nil {
+		panic(err)
+	}
+
+	return cmd
+}
@@ -0,0 +1,158 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package organization
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/nscaledev/unicli/pkg/factory"
+	"github.com/nscaledev/unicli/pkg/flags"
+	"github.com/nscaledev/unicli/pkg/resolve"
+	computev1 "github.com/unikorn-cloud/compute/pkg/apis/unikorn/v1alpha1"
+	"github.com/unikorn-cloud/core/pkg/constants"
+	identityv1 "github.com/unikorn-cloud/identity/pkg/apis/unikorn/v1alpha1"
+	kubernetesv1 "github.com/unikorn-cloud/kubernetes/pkg/apis/unikorn/v1alpha1"
+	regionv1 "github.com/unikorn-cloud/region/pkg/apis/unikorn/v1alpha1"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/labels"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+type options struct {
+	UnikornFlags *factory.UnikornFlags
+
+	delete *flags.DeleteFlags
+}
+
+func (o *options) AddFlags(cmd *cobra.Command) {
+	o.delete.AddFlags(cmd)
+}
+
+// dependent describes a kind of resource that is owned by an organization
+// and will be deprovisioned along with it.
+type dependent struct {
+	kind string
+	list client.ObjectList
+}
+
+func (o *options) listDependents(ctx context.Context, cli client.Client, organization *identityv1.Organization) ([]string, error) {
+	dependentKinds := []dependent{
+		{kind: "project", list: &identityv1.ProjectList{}},
+		{kind: "group", list: &identityv1.GroupList{}},
+		{kind: "organizationuser", list: &identityv1.OrganizationUserList{}},
+		{kind: "clustermanager", list: &kubernetesv1.ClusterManagerList{}},
+		{kind: "kubernetescluster", list: &kubernetesv1.KubernetesClusterList{}},
+		{kind: "virtualkubernetescluster", list: &kubernetesv1.VirtualKubernetesClusterList{}},
+		{kind: "instance", list: &computev1.ComputeInstanceList{}},
+		{kind: "network", list: &regionv1.NetworkList{}},
+	}
+
+	options := &client.ListOptions{
+		LabelSelector: labels.SelectorFromSet(labels.Set{
+			constants.OrganizationLabel: organization.Name,
+		}),
+	}
+
+	var dependents []string
+
+	for _, d := range dependentKinds {
+		if err := cli.List(ctx, d.list, options); err != nil {
+			return nil, fmt.Errorf("failed to list %s resources: %w", d.kind, err)
+		}
+
+		items, err := meta.ExtractList(d.list)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, item := range items {
+			object, ok := item.(client.Object)
+			if !ok {
+				continue
+			}
+
+			name := object.GetLabels()[constants.NameLabel]
+			if name == "" || name == constants.UndefinedName {
+				name = object.GetName()
+			}
+
+			dependents = append(dependents, fmt.Sprintf("%s/%s (%s)", d.kind, name, object.GetName()))
+		}
+	}
+
+	return dependents, nil
+}
+
+func (o *options) execute(ctx context.Context, cli client.Client, identifier string) error {
+	organization, err := resolve.Organization(ctx, cli, &resolve.Scope{IdentityNamespace: o.UnikornFlags.IdentityNamespace}, identifier)
+	if err != nil {
+		return err
+	}
+
+	dependents, err := o.listDependents(ctx, cli, organization)
+	if err != nil {
+		return err
+	}
+
+	name := organization.Labels[constants.NameLabel]
+
+	if err := o.delete.Confirm("organization", name, organization.Name, dependents); err != nil {
+		return err
+	}
+
+	return o.delete.Delete(ctx, cli, "organization", name, organization)
+}
+
+func Command(factory *factory.Factory) *cobra.Command {
+	o := options{
+		UnikornFlags: &factory.UnikornFlags,
+		delete:       flags.NewDeleteFlags(),
+	}
+
+	cmd := &cobra.Command{
+		Use:   "organization <name|id>",
+		Short: "Delete an organization and everything it contains",
+		Aliases: []string{
+			"org",
+		},
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: factory.OrganizationNameCompletionFunc(),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			client, err := factory.Client(ctx)
+			if err != nil {
+				return err
+			}
+
+			if err := o.execute(ctx, client, args[0]); err != nil {
+				return err
+			}
+
+			return nil
+		},
+	}
+
+	o.AddFlags(cmd)
+
+	return cmd
+}
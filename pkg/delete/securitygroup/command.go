@@ -0,0 +1,168 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package securitygroup
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/nscaledev/unicli/pkg/errors"
+	"github.com/nscaledev/unicli/pkg/factory"
+	"github.com/nscaledev/unicli/pkg/flags"
+	"github.com/nscaledev/unicli/pkg/resolve"
+	"github.com/nscaledev/unicli/pkg/util"
+	computev1 "github.com/unikorn-cloud/compute/pkg/apis/unikorn/v1alpha1"
+	"github.com/unikorn-cloud/core/pkg/constants"
+	regionconstants "github.com/unikorn-cloud/region/pkg/constants"
+
+	"k8s.io/apimachinery/pkg/labels"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+type options struct {
+	UnikornFlags *factory.UnikornFlags
+
+	organization *flags.OrganizationFlags
+	project      *flags.ProjectFlags
+	delete       *flags.DeleteFlags
+}
+
+func (o *options) AddFlags(cmd *cobra.Command, factory *factory.Factory) error {
+	if err := o.organization.AddFlags(cmd, factory, false); err != nil {
+		return err
+	}
+
+	if err := o.project.AddFlags(cmd, factory, false); err != nil {
+		return err
+	}
+
+	o.delete.AddFlags(cmd)
+
+	return nil
+}
+
+func (o *options) validate(ctx context.Context, cli client.Client) error {
+	validators := []func(context.Context, client.Client) error{
+		o.organization.Validate,
+		o.project.Validate,
+	}
+
+	for _, validator := range validators {
+		if err := validator(ctx, cli); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (o *options) execute(ctx context.Context, cli client.Client, identifier string) error {
+	scope := flags.Scope(o.UnikornFlags, o.organization, o.project)
+	scope.Namespace = o.UnikornFlags.RegionNamespace
+
+	securityGroup, err := resolve.SecurityGroup(ctx, cli, scope, identifier)
+	if err != nil {
+		return err
+	}
+
+	name := securityGroup.Labels[constants.NameLabel]
+
+	// The region service won't delete a security group while it's referenced.
+	if references := util.ResourceReferences(securityGroup); len(references) != 0 {
+		return fmt.Errorf("%w: security group %s is in use by %s", errors.ErrValidation, name, strings.Join(references, ", "))
+	}
+
+	// Compute instances the security group is applied to will lose its rules.
+	instances := &computev1.ComputeInstanceList{}
+
+	options := &client.ListOptions{
+		LabelSelector: labels.SelectorFromSet(labels.Set{
+			regionconstants.NetworkLabel: securityGroup.Labels[regionconstants.NetworkLabel],
+		}),
+	}
+
+	if err := cli.List(ctx, instances, options); err != nil {
+		return fmt.Errorf("failed to list compute instances: %w", err)
+	}
+
+	var dependents []string
+
+	for i := range instances.Items {
+		instance := &instances.Items[i]
+
+		if instance.Spec.Networking != nil && slices.Contains(instance.Spec.Networking.SecurityGroupIDs, securityGroup.Name) {
+			dependents = append(dependents, fmt.Sprintf("instance/%s (%s)", instance.Labels[constants.NameLabel], instance.Name))
+		}
+	}
+
+	if err := o.delete.Confirm("security group", name, securityGroup.Name, dependents); err != nil {
+		return err
+	}
+
+	return o.delete.Delete(ctx, cli, "security group", name, securityGroup)
+}
+
+func Command(factory *factory.Factory) *cobra.Command {
+	unikornFlags := &factory.UnikornFlags
+	organizationFlags := flags.NewOrganizationFlags(unikornFlags)
+	projectFlags := flags.NewProjectFlags(unikornFlags, organizationFlags)
+
+	o := options{
+		UnikornFlags: unikornFlags,
+		organization: organizationFlags,
+		project:      projectFlags,
+		delete:       flags.NewDeleteFlags(),
+	}
+
+	cmd := &cobra.Command{
+		Use:   "securitygroup <name|id>",
+		Short: "Delete a security group",
+		Aliases: []string{
+			"sg",
+		},
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			client, err := factory.Client(ctx)
+			if err != nil {
+				return err
+			}
+
+			if err := o.validate(ctx, client); err != nil {
+				return err
+			}
+
+			if err := o.execute(ctx, client, args[0]); err != nil {
+				return err
+			}
+
+			return nil
+		},
+	}
+
+	if err := o.AddFlags(cmd, factory); err != nil {
+		panic(err)
+	}
+
+	return cmd
+}
@@ -0,0 +1,264 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package user
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/nscaledev/unicli/pkg/errors"
+	"github.com/nscaledev/unicli/pkg/factory"
+	"github.com/nscaledev/unicli/pkg/flags"
+	"github.com/nscaledev/unicli/pkg/util"
+	"github.com/unikorn-cloud/core/pkg/constants"
+	identityv1 "github.com/unikorn-cloud/identity/pkg/apis/unikorn/v1alpha1"
+
+	"k8s.io/apimachinery/pkg/labels"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+type options struct {
+	UnikornFlags *factory.UnikornFlags
+
+	organization *flags.OrganizationFlags
+	delete       *flags.DeleteFlags
+
+	organizationNames map[string]string
+}
+
+func (o *options) AddFlags(cmd *cobra.Command, factory *factory.Factory) error {
+	if err := o.organization.AddFlags(cmd, factory, false); err != nil {
+		return err
+	}
+
+	o.delete.AddFlags(cmd)
+
+	return nil
+}
+
+func (o *options) validate(ctx context.Context, cli client.Client) error {
+	validators := []func(context.Context, client.Client) error{
+		o.organization.Validate,
+	}
+
+	for _, validator := range validators {
+		if err := validator(ctx, cli); err != nil {
+			return err
+		}
+	}
+
+	organizationNames, err := util.CreateOrganizationNameMap(ctx, cli, o.UnikornFlags.IdentityNamespace)
+	if err != nil {
+		return err
+	}
+
+	o.organizationNames = organizationNames
+
+	return nil
+}
+
+// listOrganizationUsers returns all organization memberships for a user,
+// optionally limited to the selected organization.
+func (o *options) listOrganizationUsers(ctx context.Context, cli client.Client, user *identityv1.User) ([]identityv1.OrganizationUser, error) {
+	options := &client.ListOptions{
+		LabelSelector: labels.SelectorFromSet(labels.Set{
+			constants.UserLabel: user.Name,
+		}),
+	}
+
+	if o.organization.Organization != nil {
+		options.Namespace = o.organization.Organization.Status.Namespace
+	}
+
+	resources := &identityv1.OrganizationUserList{}
+	if err := cli.List(ctx, resources, options); err != nil {
+		return nil, fmt.Errorf("failed to list organization users: %w", err)
+	}
+
+	return resources.Items, nil
+}
+
+// memberGroup is a group an organization user is a member of.
+type memberGroup struct {
+	group            *identityv1.Group
+	organizationUser *identityv1.OrganizationUser
+}
+
+// listGroups returns any groups the organization users are members of, these
+// will lose the member when it's deleted.
+func (o *options) listGroups(ctx context.Context, cli client.Client, organizationUsers []identityv1.OrganizationUser, email string) ([]memberGroup, []string, error) {
+	var (
+		memberGroups []memberGroup
+		dependents   []string
+	)
+
+	for i := range organizationUsers {
+		organizationUser := &organizationUsers[i]
+
+		groups := &identityv1.GroupList{}
+		if err := cli.List(ctx, groups, &client.ListOptions{Namespace: organizationUser.Namespace}); err != nil {
+			return nil, nil, fmt.Errorf("failed to list groups: %w", err)
+		}
+
+		for j := range groups.Items {
+			group := &groups.Items[j]
+
+			if util.IsGroupMember(group, organizationUser.Name, email) {
+				memberGroups = append(memberGroups, memberGroup{
+					group:            group,
+					organizationUser: organizationUser,
+				})
+
+				dependents = append(dependents, fmt.Sprintf("group/%s (%s)", group.Labels[constants.NameLabel], o.organizationNames[organizationUser.Labels[constants.OrganizationLabel]]))
+			}
+		}
+	}
+
+	return memberGroups, dependents, nil
+}
+
+// removeFromGroups removes the user from any groups they are members of, these
+// would otherwise be left referring to a user that no longer exists.
+func removeFromGroups(ctx context.Context, cli client.Client, memberGroups []memberGroup, email string) error {
+	for _, memberGroup := range memberGroups {
+		update := func(group *identityv1.Group) {
+			util.RemoveGroupMember(group, memberGroup.organizationUser.Name, email)
+		}
+
+		if err := util.UpdateGroup(ctx, cli, memberGroup.group, update); err != nil {
+			return fmt.Errorf("failed to remove user from group %s: %w", memberGroup.group.Name, err)
+		}
+	}
+
+	return nil
+}
+
+func (o *options) execute(ctx context.Context, cli client.Client, email string) error {
+	user, err := util.GetUser(ctx, cli, o.UnikornFlags.IdentityNamespace, email)
+	if err != nil {
+		return err
+	}
+
+	organizationUsers, err := o.listOrganizationUsers(ctx, cli, user)
+	if err != nil {
+		return err
+	}
+
+	memberGroups, dependents, err := o.listGroups(ctx, cli, organizationUsers, email)
+	if err != nil {
+		return err
+	}
+
+	// When scoped to an organization, only the membership is removed, the
+	// user may still be a member of other organizations.
+	if o.organization.Organization != nil {
+		if len(organizationUsers) == 0 {
+			return fmt.Errorf("%w: user %s is not a member of organization %s", errors.ErrValidation, email, o.organization.OrganizationName)
+		}
+
+		organizationUser := &organizationUsers[0]
+
+		if err := o.delete.Confirm("organization user", email, organizationUser.Name, dependents); err != nil {
+			return err
+		}
+
+		if err := removeFromGroups(ctx, cli, memberGroups, email); err != nil {
+			return err
+		}
+
+		return o.delete.Delete(ctx, cli, "organization user", email, organizationUser)
+	}
+
+	memberships := make([]string, 0, len(organizationUsers))
+
+	for i := range organizationUsers {
+		memberships = append(memberships, fmt.Sprintf("organizationuser/%s (%s)", organizationUsers[i].Name, o.organizationNames[organizationUsers[i].Labels[constants.OrganizationLabel]]))
+	}
+
+	if err := o.delete.Confirm("user", email, user.Name, append(memberships, dependents...)); err != nil {
+		return err
+	}
+
+	if err := removeFromGroups(ctx, cli, memberGroups, email); err != nil {
+		return err
+	}
+
+	// Remove memberships first, these would otherwise be left referring
+	// to a user that no longer exists.
+	for i := range organizationUsers {
+		if err := cli.Delete(ctx, &organizationUsers[i]); err != nil {
+			return fmt.Errorf("failed to delete organization user %s: %w", organizationUsers[i].Name, err)
+		}
+	}
+
+	return o.delete.Delete(ctx, cli, "user", email, user)
+}
+
+func Command(factory *factory.Factory) *cobra.Command {
+	unikornFlags := &factory.UnikornFlags
+
+	o := options{
+		UnikornFlags: unikornFlags,
+		organization: flags.NewOrganizationFlags(unikornFlags),
+		delete:       flags.NewDeleteFlags(),
+	}
+
+	cmd := &cobra.Command{
+		Use:   "user <email>",
+		Short: "Delete a user, or remove them from an organization",
+		Long: `Delete a user, or remove them from an organization.
+
+When --organization is specified, only the user's membership of that organization
+is removed, otherwise the user and all of their organization memberships are deleted.
+The organization of the current profile is not used.`,
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: factory.UserSubjectCompletionFunc(),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			// Only an explicit organization limits deletion to a membership,
+			// the profile's organization would silently change what's deleted.
+			if !factory.Specified(cmd, "organization") {
+				o.organization.OrganizationName = ""
+			}
+
+			client, err := factory.Client(ctx)
+			if err != nil {
+				return err
+			}
+
+			if err := o.validate(ctx, client); err != nil {
+				return err
+			}
+
+			if err := o.execute(ctx, client, args[0]); err != nil {
+				return err
+			}
+
+			return nil
+		},
+	}
+
+	if err := o.AddFlags(cmd, factory); err != nil {
+		panic(err)
+	}
+
+	return cmd
+}
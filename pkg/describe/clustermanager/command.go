@@ -19,8 +19,8 @@ package clustermanager
 import (
 	"context"
 	"fmt"
+	"os"
 	"strings"
-	"time"
 
 	"github.com/charmbracelet/lipgloss"
 	"github.com/charmbracelet/lipgloss/tree"
@@ -28,19 +28,18 @@ import (
 
 	"github.com/nscaledev/unicli/pkg/factory"
 	"github.com/nscaledev/unicli/pkg/flags"
+	"github.com/nscaledev/unicli/pkg/printer"
+	"github.com/nscaledev/unicli/pkg/resolve"
+	"github.com/nscaledev/unicli/pkg/util"
 	"github.com/unikorn-cloud/core/pkg/constants"
 	kubernetesv1 "github.com/unikorn-cloud/kubernetes/pkg/apis/unikorn/v1alpha1"
 
-	corev1 "k8s.io/api/core/v1"
-	"k8s.io/apimachinery/pkg/labels"
-
 	"sigs.k8s.io/controller-runtime/pkg/client"
-
-	"github.com/nscaledev/unicli/pkg/util"
 )
 
 type options struct {
 	UnikornFlags *factory.UnikornFlags
+	PrintFlags   *printer.Flags
 
 	organization *flags.OrganizationFlags
 }
@@ -73,24 +72,28 @@ func Command(factory *factory.Factory) *cobra.Command {
 
 	o := options{
 		UnikornFlags: unikornFlags,
+		PrintFlags:   &factory.PrintFlags,
 		organization: organizationFlags,
 	}
 
 	cmd := &cobra.Command{
-		Use:   "clustermanager [id]",
-		Short: "Show detailed information about a cluster manager by ID",
+		Use:   "clustermanager [name|id]",
+		Short: "Show detailed information about a cluster manager by name or ID",
 		Aliases: []string{
 			"cm",
 		},
 		RunE: func(cmd *cobra.Command, args []string) error {
 			if len(args) != 1 {
-				return fmt.Errorf("exactly one cluster manager ID must be specified")
+				return fmt.Errorf("exactly one cluster manager name or ID must be specified")
 			}
 
-			ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
-			defer cancel()
+			if err := o.PrintFlags.RejectCSV(); err != nil {
+				return err
+			}
 
-			client, err := factory.Client()
+			ctx := cmd.Context()
+
+			client, err := factory.Client(ctx)
 			if err != nil {
 				return err
 			}
@@ -115,45 +118,9 @@ func Command(factory *factory.Factory) *cobra.Command {
 }
 
 func (o *options) execute(ctx context.Context, cli client.Client, id string) error {
-	l := labels.Set{}
-
-	if o.organization.Organization != nil {
-		l[constants.OrganizationLabel] = o.organization.Organization.Name
-	}
-
-	namespaces := &corev1.NamespaceList{}
-	if err := cli.List(ctx, namespaces); err != nil {
-		return fmt.Errorf("failed to list namespaces: %w", err)
-	}
-
-	// Search for the cluster manager across all namespaces
-	var manager *kubernetesv1.ClusterManager
-	for _, namespace := range namespaces.Items {
-		options := &client.ListOptions{
-			LabelSelector: labels.SelectorFromSet(l),
-			Namespace:     namespace.Name,
-		}
-
-		resources := &kubernetesv1.ClusterManagerList{}
-		if err := cli.List(ctx, resources, options); err != nil {
-			return fmt.Errorf("failed to list cluster managers in namespace %s: %w", namespace.Name, err)
-		}
-
-		for i := range resources.Items {
-			resource := &resources.Items[i]
-			if resource.Name == id {
-				manager = resource
-				break
-			}
-		}
-
-		if manager != nil {
-			break
-		}
-	}
-
-	if manager == nil {
-		return fmt.Errorf("cluster manager %s not found", id)
+	manager, err := resolve.ClusterManager(ctx, cli, flags.Scope(o.UnikornFlags, o.organization, nil), id)
+	if err != nil {
+		return err
 	}
 
 	// Create maps for ID to name lookups
@@ -197,6 +164,22 @@ func (o *options) execute(ctx context.Context, cli client.Client, id string) err
 		"status":    manager.Status,
 	}
 
+	if o.PrintFlags.Structured() {
+		references := map[string]printer.Reference{
+			"organization": {
+				ID:   orgID,
+				Name: orgName,
+			},
+		}
+
+		object, err := printer.NewObject(cli.Scheme(), manager, references)
+		if err != nil {
+			return err
+		}
+
+		return o.PrintFlags.PrintObject(os.Stdout, object)
+	}
+
 	// Define styles
 	labelStyle := lipgloss.NewStyle().
 		Bold(true).
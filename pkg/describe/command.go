@@ -21,9 +21,12 @@ import (
 
 	"github.com/nscaledev/unicli/pkg/describe/clustermanager"
 	"github.com/nscaledev/unicli/pkg/describe/computeinstance"
+	"github.com/nscaledev/unicli/pkg/describe/group"
 	"github.com/nscaledev/unicli/pkg/describe/kubernetescluster"
 	"github.com/nscaledev/unicli/pkg/describe/network"
 	"github.com/nscaledev/unicli/pkg/describe/openstackidentity"
+	"github.com/nscaledev/unicli/pkg/describe/region"
+	"github.com/nscaledev/unicli/pkg/describe/securitygroup"
 	"github.com/nscaledev/unicli/pkg/describe/virtualkubernetescluster"
 	"github.com/nscaledev/unicli/pkg/factory"
 )
@@ -37,9 +40,12 @@ func Command(factory *factory.Factory) *cobra.Command {
 	cmd.AddCommand(
 		clustermanager.Command(factory),
 		computeinstance.Command(factory),
+		group.Command(factory),
 		kubernetescluster.Command(factory),
 		network.Command(factory),
 		openstackidentity.Command(factory),
+		region.Command(factory),
+		securitygroup.Command(factory),
 		virtualkubernetescluster.Command(factory),
 	)
 
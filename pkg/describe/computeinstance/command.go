@@ -19,7 +19,7 @@ package computeinstance
 import (
 	"context"
 	"fmt"
-	"time"
+	"os"
 
 	"github.com/charmbracelet/lipgloss"
 	"github.com/charmbracelet/lipgloss/tree"
@@ -27,19 +27,21 @@ import (
 
 	"github.com/nscaledev/unicli/pkg/factory"
 	"github.com/nscaledev/unicli/pkg/flags"
+	"github.com/nscaledev/unicli/pkg/printer"
+	"github.com/nscaledev/unicli/pkg/resolve"
 	"github.com/nscaledev/unicli/pkg/util"
 	computev1 "github.com/unikorn-cloud/compute/pkg/apis/unikorn/v1alpha1"
 	"github.com/unikorn-cloud/core/pkg/constants"
 	regionv1 "github.com/unikorn-cloud/region/pkg/apis/unikorn/v1alpha1"
 
-	corev1 "k8s.io/api/core/v1"
-	"k8s.io/apimachinery/pkg/labels"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
 
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 type options struct {
 	UnikornFlags *factory.UnikornFlags
+	PrintFlags   *printer.Flags
 
 	organization *flags.OrganizationFlags
 	project      *flags.ProjectFlags
@@ -79,6 +81,7 @@ func Command(factory *factory.Factory) *cobra.Command {
 
 	o := options{
 		UnikornFlags: unikornFlags,
+		PrintFlags:   &factory.PrintFlags,
 		organization: organizationFlags,
 		project:      projectFlags,
 	}
@@ -94,10 +97,13 @@ func Command(factory *factory.Factory) *cobra.Command {
 				return fmt.Errorf("exactly one compute instance name or ID must be specified")
 			}
 
-			ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
-			defer cancel()
+			if err := o.PrintFlags.RejectCSV(); err != nil {
+				return err
+			}
+
+			ctx := cmd.Context()
 
-			client, err := factory.Client()
+			client, err := factory.Client(ctx)
 			if err != nil {
 				return err
 			}
@@ -176,52 +182,54 @@ func formatFlavorDescription(fm regionv1.FlavorMetadata) string {
 	return desc
 }
 
-func (o *options) execute(ctx context.Context, cli client.Client, identifier string) error {
-	l := labels.Set{}
+// securityGroups returns the security groups applied to the instance, keyed by ID.
+// Security groups that no longer exist are omitted.
+func (o *options) securityGroups(ctx context.Context, cli client.Client, instance *computev1.ComputeInstance) (map[string]*regionv1.SecurityGroup, error) {
+	result := map[string]*regionv1.SecurityGroup{}
 
-	if o.organization.Organization != nil {
-		l[constants.OrganizationLabel] = o.organization.Organization.Name
+	if instance.Spec.Networking == nil {
+		return result, nil
 	}
 
-	if o.project.Project != nil {
-		l[constants.ProjectLabel] = o.project.Project.Name
-	}
+	for _, id := range instance.Spec.Networking.SecurityGroupIDs {
+		securityGroup := &regionv1.SecurityGroup{}
 
-	namespaces := &corev1.NamespaceList{}
-	if err := cli.List(ctx, namespaces); err != nil {
-		return fmt.Errorf("failed to list namespaces: %w", err)
+		if err := cli.Get(ctx, client.ObjectKey{Namespace: o.UnikornFlags.RegionNamespace, Name: id}, securityGroup); err != nil {
+			if kerrors.IsNotFound(err) {
+				continue
+			}
+
+			return nil, fmt.Errorf("failed to get security group %s: %w", id, err)
+		}
+
+		result[id] = securityGroup
 	}
 
-	// Search for the instance across all namespaces
-	var instance *computev1.ComputeInstance
+	return result, nil
+}
 
-	for _, namespace := range namespaces.Items {
-		options := &client.ListOptions{
-			LabelSelector: labels.SelectorFromSet(l),
-			Namespace:     namespace.Name,
-		}
+// securityGroupTree returns a tree with the security group's name and its rules,
+// or just the ID if the security group couldn't be found.
+func securityGroupTree(id string, securityGroup *regionv1.SecurityGroup, valueStyle lipgloss.Style) *tree.Tree {
+	if securityGroup == nil {
+		return tree.New().Root(valueStyle.Render(id))
+	}
 
-		resources := &computev1.ComputeInstanceList{}
-		if err := cli.List(ctx, resources, options); err != nil {
-			return fmt.Errorf("failed to list compute instances in namespace %s: %w", namespace.Name, err)
-		}
+	t := tree.New().Root(valueStyle.Render(securityGroup.Labels[constants.NameLabel]))
 
-		for i := range resources.Items {
-			resource := &resources.Items[i]
-			// Check both name and ID
-			if resource.Labels[constants.NameLabel] == identifier || resource.Name == identifier {
-				instance = resource
-				break
-			}
-		}
+	for i := range securityGroup.Spec.Rules {
+		rule := &securityGroup.Spec.Rules[i]
 
-		if instance != nil {
-			break
-		}
+		t.Child(valueStyle.Render(fmt.Sprintf("%s %s ports %s cidr %s", rule.Direction, rule.Protocol, util.SecurityGroupRulePorts(rule), util.SecurityGroupRuleCIDR(rule))))
 	}
 
-	if instance == nil {
-		return fmt.Errorf("compute instance %s not found", identifier)
+	return t
+}
+
+func (o *options) execute(ctx context.Context, cli client.Client, identifier string) error {
+	instance, err := resolve.ComputeInstance(ctx, cli, flags.Scope(o.UnikornFlags, o.organization, o.project), identifier)
+	if err != nil {
+		return err
 	}
 
 	// Create maps for ID to name lookups
@@ -265,6 +273,35 @@ func (o *options) execute(ctx context.Context, cli client.Client, identifier str
 
 	imageID := instance.Spec.ImageID
 
+	if o.PrintFlags.Structured() {
+		references := map[string]printer.Reference{
+			"organization": {
+				ID:   orgID,
+				Name: orgName,
+			},
+			"project": {
+				ID:   projID,
+				Name: projName,
+			},
+			"flavor": {
+				ID:   flavorID,
+				Name: flavorName,
+			},
+		}
+
+		object, err := printer.NewObject(cli.Scheme(), instance, references)
+		if err != nil {
+			return err
+		}
+
+		return o.PrintFlags.PrintObject(os.Stdout, object)
+	}
+
+	securityGroups, err := o.securityGroups(ctx, cli, instance)
+	if err != nil {
+		return err
+	}
+
 	// Define styles
 	labelStyle := lipgloss.NewStyle().
 		Bold(true).
@@ -312,7 +349,7 @@ func (o *options) execute(ctx context.Context, cli client.Client, identifier str
 		if len(instance.Spec.Networking.SecurityGroupIDs) > 0 {
 			sgTree := tree.New().Root("Security Groups")
 			for _, sg := range instance.Spec.Networking.SecurityGroupIDs {
-				sgTree.Child(valueStyle.Render(sg))
+				sgTree.Child(securityGroupTree(sg, securityGroups[sg], valueStyle))
 			}
 			networkTree.Child(sgTree)
 		}
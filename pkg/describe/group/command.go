@@ -0,0 +1,216 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package group
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"slices"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/lipgloss/tree"
+	"github.com/spf13/cobra"
+
+	"github.com/nscaledev/unicli/pkg/factory"
+	"github.com/nscaledev/unicli/pkg/flags"
+	"github.com/nscaledev/unicli/pkg/printer"
+	"github.com/nscaledev/unicli/pkg/resolve"
+	"github.com/nscaledev/unicli/pkg/util"
+	"github.com/unikorn-cloud/core/pkg/constants"
+	identityv1 "github.com/unikorn-cloud/identity/pkg/apis/unikorn/v1alpha1"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+type options struct {
+	UnikornFlags *factory.UnikornFlags
+	PrintFlags   *printer.Flags
+
+	organization *flags.OrganizationFlags
+}
+
+func (o *options) AddFlags(cmd *cobra.Command, factory *factory.Factory) error {
+	if err := o.organization.AddFlags(cmd, factory, true); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (o *options) validate(ctx context.Context, cli client.Client) error {
+	validators := []func(context.Context, client.Client) error{
+		o.organization.Validate,
+	}
+
+	for _, validator := range validators {
+		if err := validator(ctx, cli); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func Command(factory *factory.Factory) *cobra.Command {
+	unikornFlags := &factory.UnikornFlags
+	organizationFlags := flags.NewOrganizationFlags(unikornFlags)
+
+	o := options{
+		UnikornFlags: unikornFlags,
+		PrintFlags:   &factory.PrintFlags,
+		organization: organizationFlags,
+	}
+
+	cmd := &cobra.Command{
+		Use:               "group <name|id>",
+		Short:             "Show detailed information about a group",
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: factory.GroupNameCompletionFunc(&organizationFlags.OrganizationName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := o.PrintFlags.RejectCSV(); err != nil {
+				return err
+			}
+
+			ctx := cmd.Context()
+
+			client, err := factory.Client(ctx)
+			if err != nil {
+				return err
+			}
+
+			if err := o.validate(ctx, client); err != nil {
+				return err
+			}
+
+			if err := o.execute(ctx, client, args[0]); err != nil {
+				return err
+			}
+
+			return nil
+		},
+	}
+
+	if err := o.AddFlags(cmd, factory); err != nil {
+		panic(err)
+	}
+
+	return cmd
+}
+
+func (o *options) execute(ctx context.Context, cli client.Client, identifier string) error {
+	namespace := o.organization.Organization.Status.Namespace
+
+	scope := &resolve.Scope{
+		IdentityNamespace: o.UnikornFlags.IdentityNamespace,
+		Namespace:         namespace,
+	}
+
+	group, err := resolve.Group(ctx, cli, scope, identifier)
+	if err != nil {
+		return err
+	}
+
+	if o.PrintFlags.Structured() {
+		references := map[string]printer.Reference{
+			"organization": {
+				ID:   o.organization.Organization.Name,
+				Name: o.organization.Organization.Labels[constants.NameLabel],
+			},
+		}
+
+		object, err := printer.NewObject(cli.Scheme(), group, references)
+		if err != nil {
+			return err
+		}
+
+		return o.PrintFlags.PrintObject(os.Stdout, object)
+	}
+
+	roleNames, err := util.CreateRoleNameMap(ctx, cli, o.UnikornFlags.IdentityNamespace)
+	if err != nil {
+		return fmt.Errorf("failed to list roles: %w", err)
+	}
+
+	userEmails, err := util.CreateOrganizationUserMap(ctx, cli, o.UnikornFlags.IdentityNamespace, namespace)
+	if err != nil {
+		return fmt.Errorf("failed to list users: %w", err)
+	}
+
+	// Projects bound to the group grant its members access.
+	projects := &identityv1.ProjectList{}
+	if err := cli.List(ctx, projects, &client.ListOptions{Namespace: namespace}); err != nil {
+		return fmt.Errorf("failed to list projects: %w", err)
+	}
+
+	// Define styles
+	labelStyle := lipgloss.NewStyle().
+		Bold(true).
+		Foreground(lipgloss.Color("#1E3A8A"))
+
+	valueStyle := lipgloss.NewStyle()
+
+	basicTree := tree.New().
+		Root("Basic Information").
+		Child(fmt.Sprintf("%s%s", labelStyle.Render("Name:"), valueStyle.Render(group.Labels[constants.NameLabel]))).
+		Child(fmt.Sprintf("%s%s", labelStyle.Render("ID:"), valueStyle.Render(group.Name)))
+
+	if description, ok := group.Annotations[constants.DescriptionAnnotation]; ok {
+		basicTree.Child(fmt.Sprintf("%s%s", labelStyle.Render("Description:"), valueStyle.Render(description)))
+	}
+
+	rolesTree := tree.New().Root("Roles")
+
+	for _, id := range group.Spec.RoleIDs {
+		rolesTree.Child(valueStyle.Render(printer.NewReference(roleNames, id).Name))
+	}
+
+	usersTree := tree.New().Root("Users")
+
+	for _, id := range group.Spec.UserIDs {
+		usersTree.Child(valueStyle.Render(printer.NewReference(userEmails, id).Name))
+	}
+
+	projectsTree := tree.New().Root("Projects")
+
+	for i := range projects.Items {
+		project := &projects.Items[i]
+
+		if slices.Contains(project.Spec.GroupIDs, group.Name) {
+			projectsTree.Child(valueStyle.Render(project.Labels[constants.NameLabel]))
+		}
+	}
+
+	// Create tree
+	t := tree.New().
+		Root("Group").
+		Child(basicTree).
+		Child(
+			tree.New().
+				Root("Organization").
+				Child(fmt.Sprintf("%s%s", labelStyle.Render("ID:"), valueStyle.Render(o.organization.Organization.Name))).
+				Child(fmt.Sprintf("%s%s", labelStyle.Render("Name:"), valueStyle.Render(o.organization.Organization.Labels[constants.NameLabel]))),
+		).
+		Child(rolesTree).
+		Child(usersTree).
+		Child(projectsTree)
+
+	// Print the tree
+	fmt.Println(t)
+
+	return nil
+}
@@ -19,8 +19,8 @@ package kubernetescluster
 import (
 	"context"
 	"fmt"
+	"os"
 	"strings"
-	"time"
 
 	"github.com/charmbracelet/lipgloss"
 	"github.com/charmbracelet/lipgloss/tree"
@@ -28,20 +28,19 @@ import (
 
 	"github.com/nscaledev/unicli/pkg/factory"
 	"github.com/nscaledev/unicli/pkg/flags"
+	"github.com/nscaledev/unicli/pkg/printer"
+	"github.com/nscaledev/unicli/pkg/resolve"
+	"github.com/nscaledev/unicli/pkg/util"
 	"github.com/unikorn-cloud/core/pkg/constants"
 	kubernetesv1 "github.com/unikorn-cloud/kubernetes/pkg/apis/unikorn/v1alpha1"
 	regionv1 "github.com/unikorn-cloud/region/pkg/apis/unikorn/v1alpha1"
 
-	corev1 "k8s.io/api/core/v1"
-	"k8s.io/apimachinery/pkg/labels"
-
 	"sigs.k8s.io/controller-runtime/pkg/client"
-
-	"github.com/nscaledev/unicli/pkg/util"
 )
 
 type options struct {
 	UnikornFlags *factory.UnikornFlags
+	PrintFlags   *printer.Flags
 
 	organization *flags.OrganizationFlags
 	project      *flags.ProjectFlags
@@ -81,6 +80,7 @@ func Command(factory *factory.Factory) *cobra.Command {
 
 	o := options{
 		UnikornFlags: unikornFlags,
+		PrintFlags:   &factory.PrintFlags,
 		organization: organizationFlags,
 		project:      projectFlags,
 	}
@@ -96,10 +96,13 @@ func Command(factory *factory.Factory) *cobra.Command {
 				return fmt.Errorf("exactly one kubernetes cluster name or ID must be specified")
 			}
 
-			ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
-			defer cancel()
+			if err := o.PrintFlags.RejectCSV(); err != nil {
+				return err
+			}
+
+			ctx := cmd.Context()
 
-			client, err := factory.Client()
+			client, err := factory.Client(ctx)
 			if err != nil {
 				return err
 			}
@@ -124,50 +127,9 @@ func Command(factory *factory.Factory) *cobra.Command {
 }
 
 func (o *options) execute(ctx context.Context, cli client.Client, identifier string) error {
-	l := labels.Set{}
-
-	if o.organization.Organization != nil {
-		l[constants.OrganizationLabel] = o.organization.Organization.Name
-	}
-
-	if o.project.Project != nil {
-		l[constants.ProjectLabel] = o.project.Project.Name
-	}
-
-	namespaces := &corev1.NamespaceList{}
-	if err := cli.List(ctx, namespaces); err != nil {
-		return fmt.Errorf("failed to list namespaces: %w", err)
-	}
-
-	// Search for the cluster across all namespaces
-	var cluster *kubernetesv1.KubernetesCluster
-	for _, namespace := range namespaces.Items {
-		options := &client.ListOptions{
-			LabelSelector: labels.SelectorFromSet(l),
-			Namespace:     namespace.Name,
-		}
-
-		resources := &kubernetesv1.KubernetesClusterList{}
-		if err := cli.List(ctx, resources, options); err != nil {
-			return fmt.Errorf("failed to list clusters in namespace %s: %w", namespace.Name, err)
-		}
-
-		for i := range resources.Items {
-			resource := &resources.Items[i]
-			// Check both name and ID
-			if resource.Labels[constants.NameLabel] == identifier || resource.Name == identifier {
-				cluster = resource
-				break
-			}
-		}
-
-		if cluster != nil {
-			break
-		}
-	}
-
-	if cluster == nil {
-		return fmt.Errorf("kubernetes cluster %s not found", identifier)
+	cluster, err := resolve.KubernetesCluster(ctx, cli, flags.Scope(o.UnikornFlags, o.organization, o.project), identifier)
+	if err != nil {
+		return err
 	}
 
 	// Create maps for ID to name lookups
@@ -244,6 +206,30 @@ func (o *options) execute(ctx context.Context, cli client.Client, identifier str
 		"workloadpools":  cluster.Spec.WorkloadPools,
 	}
 
+	if o.PrintFlags.Structured() {
+		references := map[string]printer.Reference{
+			"organization": {
+				ID:   orgID,
+				Name: orgName,
+			},
+			"project": {
+				ID:   projID,
+				Name: projName,
+			},
+			"region": {
+				ID:   regionID,
+				Name: regionName,
+			},
+		}
+
+		object, err := printer.NewObject(cli.Scheme(), cluster, references)
+		if err != nil {
+			return err
+		}
+
+		return o.PrintFlags.PrintObject(os.Stdout, object)
+	}
+
 	// Define styles
 	labelStyle := lipgloss.NewStyle().
 		Bold(true).
@@ -19,7 +19,7 @@ package network
 import (
 	"context"
 	"fmt"
-	"time"
+	"os"
 
 	"github.com/charmbracelet/lipgloss"
 	"github.com/charmbracelet/lipgloss/tree"
@@ -27,18 +27,17 @@ import (
 
 	"github.com/nscaledev/unicli/pkg/factory"
 	"github.com/nscaledev/unicli/pkg/flags"
+	"github.com/nscaledev/unicli/pkg/printer"
+	"github.com/nscaledev/unicli/pkg/resolve"
 	"github.com/nscaledev/unicli/pkg/util"
 	"github.com/unikorn-cloud/core/pkg/constants"
-	regionv1 "github.com/unikorn-cloud/region/pkg/apis/unikorn/v1alpha1"
-
-	corev1 "k8s.io/api/core/v1"
-	"k8s.io/apimachinery/pkg/labels"
 
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 type options struct {
 	UnikornFlags *factory.UnikornFlags
+	PrintFlags   *printer.Flags
 
 	organization *flags.OrganizationFlags
 	project      *flags.ProjectFlags
@@ -78,6 +77,7 @@ func Command(factory *factory.Factory) *cobra.Command {
 
 	o := options{
 		UnikornFlags: unikornFlags,
+		PrintFlags:   &factory.PrintFlags,
 		organization: organizationFlags,
 		project:      projectFlags,
 	}
@@ -93,10 +93,13 @@ func Command(factory *factory.Factory) *cobra.Command {
 				return fmt.Errorf("exactly one network name or ID must be specified")
 			}
 
-			ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
-			defer cancel()
+			if err := o.PrintFlags.RejectCSV(); err != nil {
+				return err
+			}
+
+			ctx := cmd.Context()
 
-			client, err := factory.Client()
+			client, err := factory.Client(ctx)
 			if err != nil {
 				return err
 			}
@@ -121,51 +124,9 @@ func Command(factory *factory.Factory) *cobra.Command {
 }
 
 func (o *options) execute(ctx context.Context, cli client.Client, identifier string) error {
-	l := labels.Set{}
-
-	if o.organization.Organization != nil {
-		l[constants.OrganizationLabel] = o.organization.Organization.Name
-	}
-
-	if o.project.Project != nil {
-		l[constants.ProjectLabel] = o.project.Project.Name
-	}
-
-	namespaces := &corev1.NamespaceList{}
-	if err := cli.List(ctx, namespaces); err != nil {
-		return fmt.Errorf("failed to list namespaces: %w", err)
-	}
-
-	// Search for the network across all namespaces
-	var network *regionv1.Network
-
-	for _, namespace := range namespaces.Items {
-		options := &client.ListOptions{
-			LabelSelector: labels.SelectorFromSet(l),
-			Namespace:     namespace.Name,
-		}
-
-		resources := &regionv1.NetworkList{}
-		if err := cli.List(ctx, resources, options); err != nil {
-			return fmt.Errorf("failed to list networks in namespace %s: %w", namespace.Name, err)
-		}
-
-		for i := range resources.Items {
-			resource := &resources.Items[i]
-			// Check both name and ID
-			if resource.Labels[constants.NameLabel] == identifier || resource.Name == identifier {
-				network = resource
-				break
-			}
-		}
-
-		if network != nil {
-			break
-		}
-	}
-
-	if network == nil {
-		return fmt.Errorf("network %s not found", identifier)
+	network, err := resolve.Network(ctx, cli, flags.Scope(o.UnikornFlags, o.organization, o.project), identifier)
+	if err != nil {
+		return err
 	}
 
 	// Create maps for ID to name lookups
@@ -193,6 +154,26 @@ func (o *options) execute(ctx context.Context, cli client.Client, identifier str
 		projName = projID
 	}
 
+	if o.PrintFlags.Structured() {
+		references := map[string]printer.Reference{
+			"organization": {
+				ID:   orgID,
+				Name: orgName,
+			},
+			"project": {
+				ID:   projID,
+				Name: projName,
+			},
+		}
+
+		object, err := printer.NewObject(cli.Scheme(), network, references)
+		if err != nil {
+			return err
+		}
+
+		return o.PrintFlags.PrintObject(os.Stdout, object)
+	}
+
 	// Define styles
 	labelStyle := lipgloss.NewStyle().
 		Bold(true).
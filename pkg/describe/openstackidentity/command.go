@@ -19,8 +19,8 @@ package openstackidentity
 import (
 	"context"
 	"fmt"
+	"os"
 	"strings"
-	"time"
 
 	"github.com/charmbracelet/lipgloss"
 	"github.com/charmbracelet/lipgloss/tree"
@@ -28,6 +28,7 @@ import (
 
 	"github.com/nscaledev/unicli/pkg/errors"
 	"github.com/nscaledev/unicli/pkg/factory"
+	"github.com/nscaledev/unicli/pkg/printer"
 	"github.com/nscaledev/unicli/pkg/util"
 	"github.com/unikorn-cloud/core/pkg/constants"
 
@@ -36,6 +37,7 @@ import (
 
 type options struct {
 	UnikornFlags *factory.UnikornFlags
+	PrintFlags   *printer.Flags
 }
 
 func (o *options) AddFlags(cmd *cobra.Command, factory *factory.Factory) error {
@@ -66,6 +68,22 @@ func (o *options) execute(ctx context.Context, cli client.Client, args []string)
 	clusterID := strings.TrimPrefix(identity.Labels[constants.NameLabel], "kubernetes-cluster-")
 	clusterName := clusterNames[clusterID]
 
+	if o.PrintFlags.Structured() {
+		references := map[string]printer.Reference{
+			"kubernetescluster": {
+				ID:   clusterID,
+				Name: clusterName,
+			},
+		}
+
+		object, err := printer.NewObject(cli.Scheme(), identity, references)
+		if err != nil {
+			return err
+		}
+
+		return o.PrintFlags.PrintObject(os.Stdout, object)
+	}
+
 	// Define styles
 	labelStyle := lipgloss.NewStyle().
 		Bold(true).
@@ -102,6 +120,7 @@ func (o *options) execute(ctx context.Context, cli client.Client, args []string)
 func Command(factory *factory.Factory) *cobra.Command {
 	o := options{
 		UnikornFlags: &factory.UnikornFlags,
+		PrintFlags:   &factory.PrintFlags,
 	}
 
 	cmd := &cobra.Command{
@@ -116,10 +135,13 @@ Example:
   # Describe a specific OpenStack identity
   kubectl unikorn describe openstackidentity my-identity`,
 		RunE: func(cmd *cobra.Command, args []string) error {
-			ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
-			defer cancel()
+			if err := o.PrintFlags.RejectCSV(); err != nil {
+				return err
+			}
+
+			ctx := cmd.Context()
 
-			client, err := factory.Client()
+			client, err := factory.Client(ctx)
 			if err != nil {
 				return err
 			}
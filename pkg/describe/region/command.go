@@ -0,0 +1,199 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package region
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/lipgloss/tree"
+	"github.com/spf13/cobra"
+
+	"github.com/nscaledev/unicli/pkg/factory"
+	"github.com/nscaledev/unicli/pkg/printer"
+	"github.com/nscaledev/unicli/pkg/resolve"
+	"github.com/nscaledev/unicli/pkg/util"
+	"github.com/unikorn-cloud/core/pkg/constants"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+type options struct {
+	UnikornFlags *factory.UnikornFlags
+	PrintFlags   *printer.Flags
+}
+
+func Command(factory *factory.Factory) *cobra.Command {
+	o := options{
+		UnikornFlags: &factory.UnikornFlags,
+		PrintFlags:   &factory.PrintFlags,
+	}
+
+	cmd := &cobra.Command{
+		Use:               "region <name|id>",
+		Short:             "Show detailed information about a region",
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: factory.RegionNameCompletionFunc(),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := o.PrintFlags.RejectCSV(); err != nil {
+				return err
+			}
+
+			ctx := cmd.Context()
+
+			client, err := factory.Client(ctx)
+			if err != nil {
+				return err
+			}
+
+			if err := o.execute(ctx, client, args[0]); err != nil {
+				return err
+			}
+
+			return nil
+		},
+	}
+
+	return cmd
+}
+
+func (o *options) execute(ctx context.Context, cli client.Client, identifier string) error {
+	scope := &resolve.Scope{
+		IdentityNamespace: o.UnikornFlags.IdentityNamespace,
+		Namespace:         o.UnikornFlags.RegionNamespace,
+	}
+
+	region, err := resolve.Region(ctx, cli, scope, identifier)
+	if err != nil {
+		return err
+	}
+
+	if o.PrintFlags.Structured() {
+		object, err := printer.NewObject(cli.Scheme(), region, nil)
+		if err != nil {
+			return err
+		}
+
+		return o.PrintFlags.PrintObject(os.Stdout, object)
+	}
+
+	orgNames, err := util.CreateOrganizationNameMap(ctx, cli, o.UnikornFlags.IdentityNamespace)
+	if err != nil {
+		return fmt.Errorf("failed to list organizations: %w", err)
+	}
+
+	// Define styles
+	labelStyle := lipgloss.NewStyle().
+		Bold(true).
+		Foreground(lipgloss.Color("#1E3A8A"))
+
+	valueStyle := lipgloss.NewStyle()
+
+	basicTree := tree.New().
+		Root("Basic Information").
+		Child(fmt.Sprintf("%s%s", labelStyle.Render("Name:"), valueStyle.Render(region.Labels[constants.NameLabel]))).
+		Child(fmt.Sprintf("%s%s", labelStyle.Render("ID:"), valueStyle.Render(region.Name))).
+		Child(fmt.Sprintf("%s%s", labelStyle.Render("Provider:"), valueStyle.Render(string(region.Spec.Provider))))
+
+	if len(region.Status.Conditions) > 0 {
+		basicTree.Child(fmt.Sprintf("%s%s", labelStyle.Render("Status:"), valueStyle.Render(string(region.Status.Conditions[0].Reason))))
+	}
+
+	organizationsTree := tree.New().Root("Organizations")
+
+	if region.Spec.Security == nil || len(region.Spec.Security.Organizations) == 0 {
+		organizationsTree.Child(valueStyle.Render("all"))
+	} else {
+		for _, organization := range region.Spec.Security.Organizations {
+			organizationsTree.Child(valueStyle.Render(printer.NewReference(orgNames, organization.ID).Name))
+		}
+	}
+
+	flavorsTree := tree.New().Root("Flavors")
+
+	if util.ExportsAllFlavors(region) {
+		flavorsTree.Child(valueStyle.Render("all, only those with metadata are listed"))
+	}
+
+	for _, flavor := range util.RegionFlavors(region) {
+		flavorsTree.Child(fmt.Sprintf("%s%s", labelStyle.Render(flavor.ID+":"), valueStyle.Render(flavor.Description())))
+	}
+
+	t := tree.New().
+		Root("Region").
+		Child(basicTree).
+		Child(organizationsTree).
+		Child(flavorsTree)
+
+	if openstack := region.Spec.Openstack; openstack != nil {
+		openstackTree := tree.New().
+			Root("Openstack").
+			Child(fmt.Sprintf("%s%s", labelStyle.Render("Endpoint:"), valueStyle.Render(openstack.Endpoint)))
+
+		if openstack.Image != nil && openstack.Image.Selector != nil && len(openstack.Image.Selector.SigningKey) != 0 {
+			openstackTree.Child(fmt.Sprintf("%s%s", labelStyle.Render("Image Signing:"), valueStyle.Render("required")))
+		}
+
+		if network := openstack.Network; network != nil {
+			if network.ExternalNetworks != nil && network.ExternalNetworks.Selector != nil {
+				selector := network.ExternalNetworks.Selector
+
+				if len(selector.IDs) != 0 {
+					openstackTree.Child(fmt.Sprintf("%s%s", labelStyle.Render("External Network IDs:"), valueStyle.Render(strings.Join(selector.IDs, ", "))))
+				}
+
+				if len(selector.Tags) != 0 {
+					openstackTree.Child(fmt.Sprintf("%s%s", labelStyle.Render("External Network Tags:"), valueStyle.Render(strings.Join(selector.Tags, ", "))))
+				}
+			}
+
+			if network.ProviderNetworks != nil {
+				if network.ProviderNetworks.Network != nil {
+					openstackTree.Child(fmt.Sprintf("%s%s", labelStyle.Render("Physical Network:"), valueStyle.Render(*network.ProviderNetworks.Network)))
+				}
+
+				if network.ProviderNetworks.VLAN != nil {
+					vlanTree := tree.New().Root("VLAN Segments")
+
+					for _, segment := range network.ProviderNetworks.VLAN.Segments {
+						vlanTree.Child(valueStyle.Render(fmt.Sprintf("%d-%d", segment.StartID, segment.EndID)))
+					}
+
+					openstackTree.Child(vlanTree)
+				}
+			}
+		}
+
+		t.Child(openstackTree)
+	}
+
+	if kubernetes := region.Spec.Kubernetes; kubernetes != nil && kubernetes.DomainName != "" {
+		t.Child(
+			tree.New().
+				Root("Kubernetes").
+				Child(fmt.Sprintf("%s%s", labelStyle.Render("Domain Name:"), valueStyle.Render(kubernetes.DomainName))),
+		)
+	}
+
+	// Print the tree
+	fmt.Println(t)
+
+	return nil
+}
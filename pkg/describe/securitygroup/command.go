@@ -0,0 +1,269 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package securitygroup
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"slices"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/lipgloss/tree"
+	"github.com/spf13/cobra"
+
+	"github.com/nscaledev/unicli/pkg/factory"
+	"github.com/nscaledev/unicli/pkg/flags"
+	"github.com/nscaledev/unicli/pkg/printer"
+	"github.com/nscaledev/unicli/pkg/resolve"
+	"github.com/nscaledev/unicli/pkg/util"
+	computev1 "github.com/unikorn-cloud/compute/pkg/apis/unikorn/v1alpha1"
+	"github.com/unikorn-cloud/core/pkg/constants"
+	regionv1 "github.com/unikorn-cloud/region/pkg/apis/unikorn/v1alpha1"
+	regionconstants "github.com/unikorn-cloud/region/pkg/constants"
+
+	"k8s.io/apimachinery/pkg/labels"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+type options struct {
+	UnikornFlags *factory.UnikornFlags
+	PrintFlags   *printer.Flags
+
+	organization *flags.OrganizationFlags
+	project      *flags.ProjectFlags
+}
+
+func (o *options) AddFlags(cmd *cobra.Command, factory *factory.Factory) error {
+	if err := o.organization.AddFlags(cmd, factory, false); err != nil {
+		return err
+	}
+
+	if err := o.project.AddFlags(cmd, factory, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (o *options) validate(ctx context.Context, cli client.Client) error {
+	validators := []func(context.Context, client.Client) error{
+		o.organization.Validate,
+		o.project.Validate,
+	}
+
+	for _, validator := range validators {
+		if err := validator(ctx, cli); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func Command(factory *factory.Factory) *cobra.Command {
+	unikornFlags := &factory.UnikornFlags
+	organizationFlags := flags.NewOrganizationFlags(unikornFlags)
+
+	o := options{
+		UnikornFlags: unikornFlags,
+		PrintFlags:   &factory.PrintFlags,
+		organization: organizationFlags,
+		project:      flags.NewProjectFlags(unikornFlags, organizationFlags),
+	}
+
+	cmd := &cobra.Command{
+		Use:   "securitygroup <name|id>",
+		Short: "Show detailed information about a security group",
+		Aliases: []string{
+			"sg",
+		},
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := o.PrintFlags.RejectCSV(); err != nil {
+				return err
+			}
+
+			ctx := cmd.Context()
+
+			client, err := factory.Client(ctx)
+			if err != nil {
+				return err
+			}
+
+			if err := o.validate(ctx, client); err != nil {
+				return err
+			}
+
+			if err := o.execute(ctx, client, args[0]); err != nil {
+				return err
+			}
+
+			return nil
+		},
+	}
+
+	if err := o.AddFlags(cmd, factory); err != nil {
+		panic(err)
+	}
+
+	return cmd
+}
+
+// rulesTree returns a tree describing each of the security group's rules.
+func rulesTree(securityGroup *regionv1.SecurityGroup, labelStyle, valueStyle lipgloss.Style) *tree.Tree {
+	rulesTree := tree.New().Root("Rules")
+
+	for i := range securityGroup.Spec.Rules {
+		rule := &securityGroup.Spec.Rules[i]
+
+		rulesTree.Child(
+			tree.New().
+				Root(fmt.Sprintf("Rule %d", i+1)).
+				Child(fmt.Sprintf("%s%s", labelStyle.Render("Direction:"), valueStyle.Render(string(rule.Direction)))).
+				Child(fmt.Sprintf("%s%s", labelStyle.Render("Protocol:"), valueStyle.Render(string(rule.Protocol)))).
+				Child(fmt.Sprintf("%s%s", labelStyle.Render("Ports:"), valueStyle.Render(util.SecurityGroupRulePorts(rule)))).
+				Child(fmt.Sprintf("%s%s", labelStyle.Render("CIDR:"), valueStyle.Render(util.SecurityGroupRuleCIDR(rule)))),
+		)
+	}
+
+	return rulesTree
+}
+
+func (o *options) execute(ctx context.Context, cli client.Client, identifier string) error {
+	scope := flags.Scope(o.UnikornFlags, o.organization, o.project)
+	scope.Namespace = o.UnikornFlags.RegionNamespace
+
+	securityGroup, err := resolve.SecurityGroup(ctx, cli, scope, identifier)
+	if err != nil {
+		return err
+	}
+
+	orgNames, err := util.CreateOrganizationNameMap(ctx, cli, o.UnikornFlags.IdentityNamespace)
+	if err != nil {
+		return fmt.Errorf("failed to list organizations: %w", err)
+	}
+
+	projectNames, err := util.CreateProjectNameMap(ctx, cli)
+	if err != nil {
+		return fmt.Errorf("failed to list projects: %w", err)
+	}
+
+	networkID := securityGroup.Labels[regionconstants.NetworkLabel]
+
+	networkName := networkID
+
+	network := &regionv1.Network{}
+	if err := cli.Get(ctx, client.ObjectKey{Namespace: securityGroup.Namespace, Name: networkID}, network); err == nil {
+		networkName = network.Labels[constants.NameLabel]
+	}
+
+	organization := printer.NewReference(orgNames, securityGroup.Labels[constants.OrganizationLabel])
+	project := printer.NewReference(projectNames, securityGroup.Labels[constants.ProjectLabel])
+
+	if o.PrintFlags.Structured() {
+		references := map[string]printer.Reference{
+			"organization": organization,
+			"project":      project,
+			"network": {
+				ID:   networkID,
+				Name: networkName,
+			},
+		}
+
+		object, err := printer.NewObject(cli.Scheme(), securityGroup, references)
+		if err != nil {
+			return err
+		}
+
+		return o.PrintFlags.PrintObject(os.Stdout, object)
+	}
+
+	// Instances on the network may have the security group applied.
+	instances := &computev1.ComputeInstanceList{}
+
+	options := &client.ListOptions{
+		LabelSelector: labels.SelectorFromSet(labels.Set{
+			regionconstants.NetworkLabel: networkID,
+		}),
+	}
+
+	if err := cli.List(ctx, instances, options); err != nil {
+		return fmt.Errorf("failed to list compute instances: %w", err)
+	}
+
+	// Define styles
+	labelStyle := lipgloss.NewStyle().
+		Bold(true).
+		Foreground(lipgloss.Color("#1E3A8A"))
+
+	valueStyle := lipgloss.NewStyle()
+
+	basicTree := tree.New().
+		Root("Basic Information").
+		Child(fmt.Sprintf("%s%s", labelStyle.Render("Name:"), valueStyle.Render(securityGroup.Labels[constants.NameLabel]))).
+		Child(fmt.Sprintf("%s%s", labelStyle.Render("ID:"), valueStyle.Render(securityGroup.Name)))
+
+	if description, ok := securityGroup.Annotations[constants.DescriptionAnnotation]; ok {
+		basicTree.Child(fmt.Sprintf("%s%s", labelStyle.Render("Description:"), valueStyle.Render(description)))
+	}
+
+	if len(securityGroup.Status.Conditions) > 0 {
+		basicTree.Child(fmt.Sprintf("%s%s", labelStyle.Render("Status:"), valueStyle.Render(string(securityGroup.Status.Conditions[0].Reason))))
+	}
+
+	instancesTree := tree.New().Root("Instances")
+
+	for i := range instances.Items {
+		instance := &instances.Items[i]
+
+		if instance.Spec.Networking != nil && slices.Contains(instance.Spec.Networking.SecurityGroupIDs, securityGroup.Name) {
+			instancesTree.Child(valueStyle.Render(instance.Labels[constants.NameLabel]))
+		}
+	}
+
+	// Create tree
+	t := tree.New().
+		Root("Security Group").
+		Child(basicTree).
+		Child(
+			tree.New().
+				Root("Organization").
+				Child(fmt.Sprintf("%s%s", labelStyle.Render("ID:"), valueStyle.Render(organization.ID))).
+				Child(fmt.Sprintf("%s%s", labelStyle.Render("Name:"), valueStyle.Render(organization.Name))),
+		).
+		Child(
+			tree.New().
+				Root("Project").
+				Child(fmt.Sprintf("%s%s", labelStyle.Render("ID:"), valueStyle.Render(project.ID))).
+				Child(fmt.Sprintf("%s%s", labelStyle.Render("Name:"), valueStyle.Render(project.Name))),
+		).
+		Child(
+			tree.New().
+				Root("Network").
+				Child(fmt.Sprintf("%s%s", labelStyle.Render("ID:"), valueStyle.Render(networkID))).
+				Child(fmt.Sprintf("%s%s", labelStyle.Render("Name:"), valueStyle.Render(networkName))),
+		).
+		Child(rulesTree(securityGroup, labelStyle, valueStyle)).
+		Child(instancesTree)
+
+	// Print the tree
+	fmt.Println(t)
+
+	return nil
+}
@@ -19,7 +19,7 @@ package virtualkubernetescluster
 import (
 	"context"
 	"fmt"
-	"time"
+	"os"
 
 	"github.com/charmbracelet/lipgloss"
 	"github.com/charmbracelet/lipgloss/tree"
@@ -27,20 +27,19 @@ import (
 
 	"github.com/nscaledev/unicli/pkg/factory"
 	"github.com/nscaledev/unicli/pkg/flags"
+	"github.com/nscaledev/unicli/pkg/printer"
+	"github.com/nscaledev/unicli/pkg/resolve"
+	"github.com/nscaledev/unicli/pkg/util"
 	"github.com/unikorn-cloud/core/pkg/constants"
 	kubernetesv1 "github.com/unikorn-cloud/kubernetes/pkg/apis/unikorn/v1alpha1"
 	regionv1 "github.com/unikorn-cloud/region/pkg/apis/unikorn/v1alpha1"
 
-	corev1 "k8s.io/api/core/v1"
-	"k8s.io/apimachinery/pkg/labels"
-
 	"sigs.k8s.io/controller-runtime/pkg/client"
-
-	"github.com/nscaledev/unicli/pkg/util"
 )
 
 type options struct {
 	UnikornFlags *factory.UnikornFlags
+	PrintFlags   *printer.Flags
 
 	organization *flags.OrganizationFlags
 	project      *flags.ProjectFlags
@@ -80,12 +79,13 @@ func Command(factory *factory.Factory) *cobra.Command {
 
 	o := options{
 		UnikornFlags: unikornFlags,
+		PrintFlags:   &factory.PrintFlags,
 		organization: organizationFlags,
 		project:      projectFlags,
 	}
 
 	cmd := &cobra.Command{
-		Use:   "virtualkubernetescluster [name]",
+		Use:   "virtualkubernetescluster [name|id]",
 		Short: "Show detailed information about a virtual kubernetes cluster",
 		Aliases: []string{
 			"vkc",
@@ -95,10 +95,13 @@ func Command(factory *factory.Factory) *cobra.Command {
 				return fmt.Errorf("exactly one virtual kubernetes cluster name must be specified")
 			}
 
-			ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
-			defer cancel()
+			if err := o.PrintFlags.RejectCSV(); err != nil {
+				return err
+			}
+
+			ctx := cmd.Context()
 
-			client, err := factory.Client()
+			client, err := factory.Client(ctx)
 			if err != nil {
 				return err
 			}
@@ -123,49 +126,9 @@ func Command(factory *factory.Factory) *cobra.Command {
 }
 
 func (o *options) execute(ctx context.Context, cli client.Client, identifier string) error {
-	l := labels.Set{}
-
-	if o.organization.Organization != nil {
-		l[constants.OrganizationLabel] = o.organization.Organization.Name
-	}
-
-	if o.project.Project != nil {
-		l[constants.ProjectLabel] = o.project.Project.Name
-	}
-
-	namespaces := &corev1.NamespaceList{}
-	if err := cli.List(ctx, namespaces); err != nil {
-		return fmt.Errorf("failed to list namespaces: %w", err)
-	}
-
-	// Search for the cluster across all namespaces
-	var cluster *kubernetesv1.VirtualKubernetesCluster
-	for _, namespace := range namespaces.Items {
-		options := &client.ListOptions{
-			LabelSelector: labels.SelectorFromSet(l),
-			Namespace:     namespace.Name,
-		}
-
-		resources := &kubernetesv1.VirtualKubernetesClusterList{}
-		if err := cli.List(ctx, resources, options); err != nil {
-			return fmt.Errorf("failed to list clusters in namespace %s: %w", namespace.Name, err)
-		}
-
-		for i := range resources.Items {
-			resource := &resources.Items[i]
-			if resource.Labels[constants.NameLabel] == identifier || resource.Name == identifier {
-				cluster = resource
-				break
-			}
-		}
-
-		if cluster != nil {
-			break
-		}
-	}
-
-	if cluster == nil {
-		return fmt.Errorf("virtual kubernetes cluster %s not found", identifier)
+	cluster, err := resolve.VirtualKubernetesCluster(ctx, cli, flags.Scope(o.UnikornFlags, o.organization, o.project), identifier)
+	if err != nil {
+		return err
 	}
 
 	// Create maps for ID to name lookups
@@ -228,6 +191,30 @@ func (o *options) execute(ctx context.Context, cli client.Client, identifier str
 		"status":        cluster.Status,
 	}
 
+	if o.PrintFlags.Structured() {
+		references := map[string]printer.Reference{
+			"organization": {
+				ID:   orgID,
+				Name: orgName,
+			},
+			"project": {
+				ID:   projID,
+				Name: projName,
+			},
+			"region": {
+				ID:   regionID,
+				Name: regionName,
+			},
+		}
+
+		object, err := printer.NewObject(cli.Scheme(), cluster, references)
+		if err != nil {
+			return err
+		}
+
+		return o.PrintFlags.PrintObject(os.Stdout, object)
+	}
+
 	// Define styles
 	labelStyle := lipgloss.NewStyle().
 		Bold(true).
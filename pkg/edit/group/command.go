@@ -0,0 +1,377 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package group
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/spf13/cobra"
+
+	"github.com/nscaledev/unicli/pkg/errors"
+	"github.com/nscaledev/unicli/pkg/factory"
+	"github.com/nscaledev/unicli/pkg/flags"
+	"github.com/nscaledev/unicli/pkg/resolve"
+	"github.com/nscaledev/unicli/pkg/util"
+	"github.com/unikorn-cloud/core/pkg/constants"
+	identityv1 "github.com/unikorn-cloud/identity/pkg/apis/unikorn/v1alpha1"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+type options struct {
+	UnikornFlags *factory.UnikornFlags
+
+	organization *flags.OrganizationFlags
+	edit         *flags.EditFlags
+	addUsers     []string
+	removeUsers  []string
+	addRoles     []string
+	removeRoles  []string
+}
+
+func (o *options) AddFlags(cmd *cobra.Command, factory *factory.Factory) error {
+	cmd.Flags().StringSliceVar(&o.addUsers, "add-user", nil, "Add a user to the group, may be specified more than once.")
+	cmd.Flags().StringSliceVar(&o.removeUsers, "remove-user", nil, "Remove a user from the group, may be specified more than once.")
+	cmd.Flags().StringSliceVar(&o.addRoles, "add-role", nil, "Grant a role to the group, may be specified more than once.")
+	cmd.Flags().StringSliceVar(&o.removeRoles, "remove-role", nil, "Revoke a role from the group, may be specified more than once.")
+
+	cmd.MarkFlagsOneRequired("add-user", "remove-user", "add-role", "remove-role")
+
+	for _, name := range []string{"add-user", "remove-user"} {
+		if err := cmd.RegisterFlagCompletionFunc(name, factory.UserSubjectCompletionFunc()); err != nil {
+			return err
+		}
+	}
+
+	for _, name := range []string{"add-role", "remove-role"} {
+		if err := cmd.RegisterFlagCompletionFunc(name, factory.RoleNameCompletionFunc()); err != nil {
+			return err
+		}
+	}
+
+	if err := o.organization.AddFlags(cmd, factory, true); err != nil {
+		return err
+	}
+
+	o.edit.AddFlags(cmd)
+
+	return nil
+}
+
+// validateChanges ensures nothing is both added and removed.
+func (o *options) validateChanges(ctx context.Context, cli client.Client) error {
+	for _, user := range o.addUsers {
+		if slices.Contains(o.removeUsers, user) {
+			return fmt.Errorf("%w: user %s cannot be both added and removed", errors.ErrValidation, user)
+		}
+	}
+
+	for _, role := range o.addRoles {
+		if slices.Contains(o.removeRoles, role) {
+			return fmt.Errorf("%w: role %s cannot be both added and removed", errors.ErrValidation, role)
+		}
+	}
+
+	return nil
+}
+
+func (o *options) validate(ctx context.Context, cli client.Client) error {
+	validators := []func(context.Context, client.Client) error{
+		o.organization.Validate,
+		o.validateChanges,
+	}
+
+	for _, validator := range validators {
+		if err := validator(ctx, cli); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// membership is a set of group members, roles or users, that tracks changes so
+// they can be shown to the user before being applied.
+type membership struct {
+	kind    string
+	ids     []string
+	names   map[string]string
+	added   []string
+	removed []string
+}
+
+func newMembership(kind string, ids []string) *membership {
+	return &membership{
+		kind:  kind,
+		ids:   slices.Clone(ids),
+		names: map[string]string{},
+	}
+}
+
+func (m *membership) add(id, name string) {
+	m.names[id] = name
+
+	if slices.Contains(m.ids, id) {
+		return
+	}
+
+	m.ids = append(m.ids, id)
+	m.added = append(m.added, id)
+}
+
+func (m *membership) remove(id, name string) {
+	m.names[id] = name
+
+	if !slices.Contains(m.ids, id) {
+		return
+	}
+
+	m.ids = slices.DeleteFunc(m.ids, func(member string) bool {
+		return member == id
+	})
+	m.removed = append(m.removed, id)
+}
+
+func (m *membership) changed() bool {
+	return len(m.added) != 0 || len(m.removed) != 0
+}
+
+// diff describes the resulting membership, additions are prefixed with "+",
+// removals with "-", and unchanged members are included for context.
+func (m *membership) diff(names map[string]string) []string {
+	name := func(id string) string {
+		if name, ok := m.names[id]; ok {
+			return name
+		}
+
+		if name, ok := names[id]; ok && name != "" {
+			return name
+		}
+
+		return id
+	}
+
+	lines := make([]string, 0, len(m.ids)+len(m.removed))
+
+	for _, id := range m.ids {
+		prefix := " "
+
+		if slices.Contains(m.added, id) {
+			prefix = "+"
+		}
+
+		lines = append(lines, fmt.Sprintf("%s %s %s", prefix, m.kind, name(id)))
+	}
+
+	for _, id := range m.removed {
+		lines = append(lines, fmt.Sprintf("- %s %s", m.kind, name(id)))
+	}
+
+	return lines
+}
+
+// role resolves a role, protected roles are internal and cannot be granted or
+// revoked.
+func (o *options) role(ctx context.Context, cli client.Client, identifier string) (*identityv1.Role, error) {
+	scope := &resolve.Scope{
+		IdentityNamespace: o.UnikornFlags.IdentityNamespace,
+		Namespace:         o.UnikornFlags.IdentityNamespace,
+	}
+
+	role, err := resolve.Role(ctx, cli, scope, identifier)
+	if err != nil {
+		return nil, err
+	}
+
+	if role.Spec.Protected {
+		return nil, fmt.Errorf("%w: role %s is protected and cannot be granted or revoked", errors.ErrValidation, identifier)
+	}
+
+	return role, nil
+}
+
+func (o *options) editRoles(ctx context.Context, cli client.Client, group *identityv1.Group) (*membership, error) {
+	roles := newMembership("role", group.Spec.RoleIDs)
+
+	for _, identifier := range o.addRoles {
+		role, err := o.role(ctx, cli, identifier)
+		if err != nil {
+			return nil, err
+		}
+
+		roles.add(role.Name, role.Labels[constants.NameLabel])
+	}
+
+	for _, identifier := range o.removeRoles {
+		role, err := o.role(ctx, cli, identifier)
+		if err != nil {
+			return nil, err
+		}
+
+		roles.remove(role.Name, role.Labels[constants.NameLabel])
+	}
+
+	return roles, nil
+}
+
+// editUsers updates the group's users, groups refer to organization users, and
+// also record the user's subject, which is what tokens are checked against.
+func (o *options) editUsers(ctx context.Context, cli client.Client, group *identityv1.Group) (*membership, error) {
+	users := newMembership("user", group.Spec.UserIDs)
+
+	for _, email := range o.addUsers {
+		organizationUser, err := util.GetOrganizationUser(ctx, cli, o.UnikornFlags.IdentityNamespace, o.organization.Organization, email)
+		if err != nil {
+			return nil, err
+		}
+
+		users.add(organizationUser.Name, email)
+
+		subject := identityv1.GroupSubject{
+			ID:    email,
+			Email: email,
+		}
+
+		if !slices.Contains(group.Spec.Subjects, subject) {
+			group.Spec.Subjects = append(group.Spec.Subjects, subject)
+		}
+	}
+
+	for _, email := range o.removeUsers {
+		organizationUser, err := util.GetOrganizationUser(ctx, cli, o.UnikornFlags.IdentityNamespace, o.organization.Organization, email)
+		if err != nil {
+			return nil, err
+		}
+
+		users.remove(organizationUser.Name, email)
+
+		// User IDs are replaced by the membership once confirmed.
+		util.RemoveGroupMember(group, organizationUser.Name, email)
+	}
+
+	return users, nil
+}
+
+func (o *options) execute(ctx context.Context, cli client.Client, identifier string) error {
+	namespace := o.organization.Organization.Status.Namespace
+
+	scope := &resolve.Scope{
+		IdentityNamespace: o.UnikornFlags.IdentityNamespace,
+		Namespace:         namespace,
+	}
+
+	group, err := resolve.Group(ctx, cli, scope, identifier)
+	if err != nil {
+		return err
+	}
+
+	updated := group.DeepCopy()
+
+	roles, err := o.editRoles(ctx, cli, updated)
+	if err != nil {
+		return err
+	}
+
+	users, err := o.editUsers(ctx, cli, updated)
+	if err != nil {
+		return err
+	}
+
+	name := group.Labels[constants.NameLabel]
+
+	if !roles.changed() && !users.changed() {
+		fmt.Printf("group %s unchanged\n", name)
+
+		return nil
+	}
+
+	roleNames, err := util.CreateRoleNameMap(ctx, cli, o.UnikornFlags.IdentityNamespace)
+	if err != nil {
+		return fmt.Errorf("failed to list roles: %w", err)
+	}
+
+	userEmails, err := util.CreateOrganizationUserMap(ctx, cli, o.UnikornFlags.IdentityNamespace, namespace)
+	if err != nil {
+		return fmt.Errorf("failed to list users: %w", err)
+	}
+
+	if err := o.edit.Confirm("group", name, group.Name, append(roles.diff(roleNames), users.diff(userEmails)...)); err != nil {
+		return err
+	}
+
+	updated.Spec.RoleIDs = roles.ids
+	updated.Spec.UserIDs = users.ids
+
+	return o.edit.Patch(ctx, cli, "group", name, group, updated)
+}
+
+func Command(factory *factory.Factory) *cobra.Command {
+	unikornFlags := &factory.UnikornFlags
+	organizationFlags := flags.NewOrganizationFlags(unikornFlags)
+
+	o := options{
+		UnikornFlags: unikornFlags,
+		organization: organizationFlags,
+		edit:         flags.NewEditFlags(),
+	}
+
+	cmd := &cobra.Command{
+		Use:   "group <name|id>",
+		Short: "Edit a group's users and roles",
+		Long: `Edit a group's users and roles.
+
+Users are specified by email address, and must be members of the group's
+organization.  The resulting membership is shown, and must be confirmed,
+before any changes are made.  If the group is modified by someone else in the
+meantime, the change is rejected and must be retried.
+
+Examples:
+  # Add a user to a group
+  unicli edit group platform --organization acme --add-user jo@acme.com
+
+  # Swap a group's role without confirmation
+  unicli edit group platform --organization acme --add-role administrator --remove-role user --yes`,
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: factory.GroupNameCompletionFunc(&organizationFlags.OrganizationName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			client, err := factory.Client(ctx)
+			if err != nil {
+				return err
+			}
+
+			if err := o.validate(ctx, client); err != nil {
+				return err
+			}
+
+			if err := o.execute(ctx, client, args[0]); err != nil {
+				return err
+			}
+
+			return nil
+		},
+	}
+
+	if err := o.AddFlags(cmd, factory); err != nil {
+		panic(err)
+	}
+
+	return cmd
+}
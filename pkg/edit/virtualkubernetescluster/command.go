@@ -0,0 +1,243 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package virtualkubernetescluster
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/nscaledev/unicli/pkg/errors"
+	"github.com/nscaledev/unicli/pkg/factory"
+	"github.com/nscaledev/unicli/pkg/flags"
+	"github.com/nscaledev/unicli/pkg/resolve"
+	"github.com/nscaledev/unicli/pkg/util"
+	"github.com/unikorn-cloud/core/pkg/constants"
+	kubernetesv1 "github.com/unikorn-cloud/kubernetes/pkg/apis/unikorn/v1alpha1"
+	regionv1 "github.com/unikorn-cloud/region/pkg/apis/unikorn/v1alpha1"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+)
+
+type options struct {
+	UnikornFlags *factory.UnikornFlags
+
+	organization *flags.OrganizationFlags
+	project      *flags.ProjectFlags
+	edit         *flags.EditFlags
+}
+
+func (o *options) AddFlags(cmd *cobra.Command, factory *factory.Factory) error {
+	if err := o.organization.AddFlags(cmd, factory, false); err != nil {
+		return err
+	}
+
+	if err := o.project.AddFlags(cmd, factory, false); err != nil {
+		return err
+	}
+
+	o.edit.AddFlags(cmd)
+
+	return nil
+}
+
+func (o *options) validate(ctx context.Context, cli client.Client) error {
+	validators := []func(context.Context, client.Client) error{
+		o.organization.Validate,
+		o.project.Validate,
+	}
+
+	for _, validator := range validators {
+		if err := validator(ctx, cli); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// validatePools ensures the edited workload pools can be provisioned, pool names
+// must be unique, replica counts sensible, and flavors available in the region.
+// Flavors given by name are replaced with their ID.
+func validatePools(region *regionv1.Region, pools []kubernetesv1.VirtualKubernetesClusterWorkloadPoolSpec) error {
+	names := map[string]bool{}
+
+	for i := range pools {
+		pool := &pools[i]
+
+		if pool.Name == "" {
+			return fmt.Errorf("%w: workload pool name must be specified", errors.ErrValidation)
+		}
+
+		if names[pool.Name] {
+			return fmt.Errorf("%w: workload pool %s specified more than once", errors.ErrValidation, pool.Name)
+		}
+
+		names[pool.Name] = true
+
+		if pool.Replicas < 0 {
+			return fmt.Errorf("%w: workload pool %s replicas must be non-negative", errors.ErrValidation, pool.Name)
+		}
+
+		flavor, err := util.ResolveFlavor(region, pool.FlavorID)
+		if err != nil {
+			return err
+		}
+
+		pool.FlavorID = flavor
+	}
+
+	return nil
+}
+
+func (o *options) execute(ctx context.Context, cli client.Client, identifier string) error {
+	cluster, err := resolve.VirtualKubernetesCluster(ctx, cli, flags.Scope(o.UnikornFlags, o.organization, o.project), identifier)
+	if err != nil {
+		return err
+	}
+
+	region, err := util.GetRegion(ctx, cli, o.UnikornFlags.RegionNamespace, cluster.Spec.RegionID)
+	if err != nil {
+		return fmt.Errorf("failed to get region %s: %w", cluster.Spec.RegionID, err)
+	}
+
+	name := cluster.Labels[constants.NameLabel]
+
+	before, err := yaml.Marshal(cluster.Spec)
+	if err != nil {
+		return err
+	}
+
+	header := fmt.Sprintf("# Editing virtual kubernetes cluster %s (%s), lines beginning with '#' are ignored.\n# Changes will be shown for confirmation before they are applied.\n", name, cluster.Name)
+
+	data, err := util.Edit("unicli-virtualkubernetescluster-*.yaml", append([]byte(header), before...))
+	if err != nil {
+		return err
+	}
+
+	var spec kubernetesv1.VirtualKubernetesClusterSpec
+
+	if err := yaml.UnmarshalStrict(data, &spec); err != nil {
+		return fmt.Errorf("%w: unable to parse edited virtual kubernetes cluster %s: %w", errors.ErrValidation, name, err)
+	}
+
+	// The cluster cannot move, its workload pools are provisioned in the region.
+	if spec.RegionID != cluster.Spec.RegionID {
+		return fmt.Errorf("%w: regionId cannot be changed", errors.ErrValidation)
+	}
+
+	if err := validatePools(region, spec.WorkloadPools); err != nil {
+		return err
+	}
+
+	// Compare canonical forms, so formatting and comments aren't changes.
+	after, err := yaml.Marshal(spec)
+	if err != nil {
+		return err
+	}
+
+	if bytes.Equal(before, after) {
+		fmt.Printf("virtual kubernetes cluster %s unchanged\n", name)
+
+		return nil
+	}
+
+	if err := o.edit.Confirm("virtual kubernetes cluster", name, cluster.Name, util.DiffLines(before, after)); err != nil {
+		return err
+	}
+
+	updated := cluster.DeepCopy()
+	updated.Spec = spec
+
+	revert, err := util.UpdateAllocation(ctx, cli, cluster.Namespace, cluster, util.VirtualKubernetesClusterAllocations(region, &spec))
+	if err != nil {
+		return err
+	}
+
+	if err := o.edit.Patch(ctx, cli, "virtual kubernetes cluster", name, cluster, updated); err != nil {
+		if rerr := revert(ctx); rerr != nil {
+			return fmt.Errorf("%w: %w", err, rerr)
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+func Command(factory *factory.Factory) *cobra.Command {
+	unikornFlags := &factory.UnikornFlags
+	organizationFlags := flags.NewOrganizationFlags(unikornFlags)
+	projectFlags := flags.NewProjectFlags(unikornFlags, organizationFlags)
+
+	o := options{
+		UnikornFlags: unikornFlags,
+		organization: organizationFlags,
+		project:      projectFlags,
+		edit:         flags.NewEditFlags(),
+	}
+
+	cmd := &cobra.Command{
+		Use:   "virtualkubernetescluster <name|id>",
+		Short: "Edit a virtual kubernetes cluster's specification",
+		Long: `Edit a virtual kubernetes cluster's specification.
+
+The specification is opened in $VISUAL or $EDITOR, falling back to vi.  Once
+saved, workload pools are validated to have unique names, non-negative replica
+counts and flavors available in the cluster's region.  The region cannot be
+changed.  The changes are shown, and must be confirmed, before they are applied,
+and the cluster's quota allocation is updated to match.  Saving an empty file
+cancels the edit.  If the cluster is modified by
+someone else in the meantime, the edit is rejected and must be retried.
+
+Examples:
+  # Edit a cluster's workload pools
+  unicli edit virtualkubernetescluster sandbox --organization acme --project web`,
+		Aliases: []string{
+			"vkc",
+		},
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: factory.VirtualKubernetesClusterNameCompletionFunc(&organizationFlags.OrganizationName, &projectFlags.ProjectName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			client, err := factory.Client(ctx)
+			if err != nil {
+				return err
+			}
+
+			if err := o.validate(ctx, client); err != nil {
+				return err
+			}
+
+			if err := o.execute(ctx, client, args[0]); err != nil {
+				return err
+			}
+
+			return nil
+		},
+	}
+
+	if err := o.AddFlags(cmd, factory); err != nil {
+		panic(err)
+	}
+
+	return cmd
+}
@@ -24,4 +24,27 @@ var (
 	ErrValidation = errors.New("validation error")
 
 	ErrResource = errors.New("resource error")
+
+	ErrAborted = errors.New("operation aborted")
+
+	ErrTimeout = errors.New("timed out")
+
+	ErrRequestTimeout = errors.New("request timed out")
+
+	ErrFailed = errors.New("resource failed")
 )
+
+// ExitCode returns the process exit code for an error, so scripts can tell a
+// timeout apart from a resource that has failed, and from an unresponsive API.
+func ExitCode(err error) int {
+	switch {
+	case errors.Is(err, ErrTimeout):
+		return 2
+	case errors.Is(err, ErrFailed):
+		return 3
+	case errors.Is(err, ErrRequestTimeout):
+		return 4
+	}
+
+	return 1
+}
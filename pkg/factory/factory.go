@@ -18,12 +18,18 @@ package factory
 
 import (
 	"context"
+	goerrors "errors"
+	"fmt"
 	"slices"
+	"time"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
 
-	"github.com/nscaledev/unicli/pkg/util"
+	"github.com/nscaledev/unicli/pkg/errors"
+	"github.com/nscaledev/unicli/pkg/printer"
+	"github.com/nscaledev/unicli/pkg/profile"
+	"github.com/nscaledev/unicli/pkg/resolve"
 	computev1 "github.com/unikorn-cloud/compute/pkg/apis/unikorn/v1alpha1"
 	"github.com/unikorn-cloud/core/pkg/constants"
 	identityv1 "github.com/unikorn-cloud/identity/pkg/apis/unikorn/v1alpha1"
@@ -34,6 +40,7 @@ import (
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	k8sscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/clientcmd"
 
 	"sigs.k8s.io/controller-runtime/pkg/cache"
@@ -61,13 +68,24 @@ func getScheme() (*runtime.Scheme, error) {
 }
 
 type UnikornFlags struct {
+	Profile           string
 	Kubeconfig        string
+	Context           string
 	IdentityNamespace string
 	RegionNamespace   string
+	ComputeNamespace  string
+	RequestTimeout    time.Duration
+}
+
+// ServiceNamespaces returns the namespaces services keep their own resources
+// in, rather than in organization or project namespaces.
+func (f *UnikornFlags) ServiceNamespaces() []string {
+	return []string{f.IdentityNamespace, f.RegionNamespace, f.ComputeNamespace}
 }
 
 type Factory struct {
 	UnikornFlags UnikornFlags
+	PrintFlags   printer.Flags
 }
 
 func NewFactory() *Factory {
@@ -75,13 +93,100 @@ func NewFactory() *Factory {
 }
 
 func (f *Factory) AddFlags(flags *pflag.FlagSet) {
-	loadingRules := clientcmd.NewDefaultClientConfigLoadingRules()
-	flags.StringVar(&f.UnikornFlags.Kubeconfig, "kubeconfig", loadingRules.GetDefaultFilename(), "Kubernetes configuration file")
+	flags.StringVar(&f.UnikornFlags.Profile, "profile", "", "Configuration profile to use, overriding the current profile")
+	flags.StringVar(&f.UnikornFlags.Kubeconfig, "kubeconfig", "", "Kubernetes configuration file, defaults to the files listed in $KUBECONFIG, or ~/.kube/config")
+	flags.StringVar(&f.UnikornFlags.Context, "context", "", "Kubernetes configuration context of the management cluster, defaults to the current context")
 	flags.StringVar(&f.UnikornFlags.IdentityNamespace, "identity-namespace", "unikorn-identity", "Identity service namespace")
 	flags.StringVar(&f.UnikornFlags.RegionNamespace, "region-namespace", "unikorn-region", "Region service namespace")
+	flags.StringVar(&f.UnikornFlags.ComputeNamespace, "compute-namespace", "unikorn-compute", "Compute service namespace")
+	flags.DurationVar(&f.UnikornFlags.RequestTimeout, "request-timeout", time.Minute, "Time to wait for a single API call to complete, zero means wait forever")
+
+	for _, name := range []string{"kubeconfig", "context", "identity-namespace", "region-namespace", "compute-namespace"} {
+		f.ProfileDefault(flags, name)
+	}
+
+	f.PrintFlags.AddFlags(flags)
+}
+
+const (
+	// profileAnnotation marks a flag as defaulting to the active profile's
+	// value of the same name.
+	profileAnnotation = "unicli_profile_default"
+
+	// profileAppliedAnnotation marks a flag as having been set from the
+	// active profile.
+	profileAppliedAnnotation = "unicli_profile_applied"
+)
+
+// ProfileDefault marks a flag as falling back to the active profile when it's
+// not explicitly specified.
+func (f *Factory) ProfileDefault(flags *pflag.FlagSet, name string) {
+	// NOTE: this only fails if the flag doesn't exist, which is a programming
+	// error, not a runtime one.
+	if err := flags.SetAnnotation(name, profileAnnotation, []string{"true"}); err != nil {
+		panic(err)
+	}
+}
+
+// ApplyProfile sets any flags that weren't explicitly specified from the active
+// profile.  This must be called after flags are parsed, but before required flags
+// are checked, so is intended to be run as a persistent pre-run hook.
+func (f *Factory) ApplyProfile(cmd *cobra.Command) error {
+	config, err := profile.Load()
+	if err != nil {
+		return err
+	}
+
+	active, err := config.Active(f.UnikornFlags.Profile)
+	if err != nil {
+		return err
+	}
+
+	if active == nil {
+		return nil
+	}
+
+	values := active.Values()
+
+	var errs []error
+
+	cmd.Flags().VisitAll(func(flag *pflag.Flag) {
+		if _, ok := flag.Annotations[profileAnnotation]; !ok || flag.Changed {
+			return
+		}
+
+		if value := values[flag.Name]; value != "" {
+			errs = append(errs, cmd.Flags().Set(flag.Name, value))
+			errs = append(errs, cmd.Flags().SetAnnotation(flag.Name, profileAppliedAnnotation, []string{"true"}))
+		}
+	})
+
+	return goerrors.Join(errs...)
+}
+
+// Specified returns true if a flag was given on the command line.  Flags set
+// from the active profile are marked as changed, so required flag checks pass,
+// but are only defaults.
+func (f *Factory) Specified(cmd *cobra.Command, name string) bool {
+	flag := cmd.Flags().Lookup(name)
+	if flag == nil || !flag.Changed {
+		return false
+	}
+
+	_, applied := flag.Annotations[profileAppliedAnnotation]
+
+	return !applied
 }
 
 func (f *Factory) RegisterCompletionFunctions(cmd *cobra.Command) error {
+	if err := cmd.RegisterFlagCompletionFunc("profile", f.ProfileNameCompletionFunc()); err != nil {
+		return err
+	}
+
+	if err := cmd.RegisterFlagCompletionFunc("context", f.ContextNameCompletionFunc()); err != nil {
+		return err
+	}
+
 	if err := cmd.RegisterFlagCompletionFunc("identity-namespace", f.NamespaceCompletionFunc()); err != nil {
 		return err
 	}
@@ -90,14 +195,66 @@ func (f *Factory) RegisterCompletionFunctions(cmd *cobra.Command) error {
 		return err
 	}
 
+	if err := cmd.RegisterFlagCompletionFunc("compute-namespace", f.NamespaceCompletionFunc()); err != nil {
+		return err
+	}
+
+	if err := cmd.RegisterFlagCompletionFunc("output", f.PrintFlags.CompletionFunc()); err != nil {
+		return err
+	}
+
 	return nil
 }
 
-func (f *Factory) Client() (client.Client, error) {
-	// TODO: signal handler and cancel.
-	ctx := context.Background()
+// clientConfig returns the Kubernetes client configuration for the named context,
+// or the current context if empty.  Like kubectl, an explicit --kubeconfig is used
+// on its own, otherwise the files listed in $KUBECONFIG are merged.
+func (f *Factory) clientConfig(context string) clientcmd.ClientConfig {
+	loadingRules := clientcmd.NewDefaultClientConfigLoadingRules()
+	loadingRules.ExplicitPath = f.UnikornFlags.Kubeconfig
 
-	config, err := clientcmd.BuildConfigFromFlags("", f.UnikornFlags.Kubeconfig)
+	overrides := &clientcmd.ConfigOverrides{
+		CurrentContext: context,
+	}
+
+	return clientcmd.NewNonInteractiveDeferredLoadingClientConfig(loadingRules, overrides)
+}
+
+// RESTConfig returns the client configuration for the management cluster, for
+// use by clients other than controller-runtime e.g. port forwarding.
+func (f *Factory) RESTConfig() (*rest.Config, error) {
+	return f.clientConfig(f.UnikornFlags.Context).ClientConfig()
+}
+
+// Contexts returns the names of all contexts in the Kubernetes configuration.
+func (f *Factory) Contexts() ([]string, error) {
+	config, err := f.clientConfig("").RawConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	names := make([]string, 0, len(config.Contexts))
+
+	for name := range config.Contexts {
+		names = append(names, name)
+	}
+
+	slices.Sort(names)
+
+	return names, nil
+}
+
+// Client returns a client for the management cluster.  Reads go directly to the
+// API server, commands are short lived so the cost of starting informers and
+// waiting for them to sync far outweighs any benefit.
+func (f *Factory) Client(ctx context.Context) (client.Client, error) {
+	return f.ContextClient(ctx, f.UnikornFlags.Context)
+}
+
+// ContextClient returns a client for the management cluster referred to by the
+// named context, for commands that operate on multiple management clusters.
+func (f *Factory) ContextClient(ctx context.Context, context string) (client.Client, error) {
+	config, err := f.clientConfig(context).ClientConfig()
 	if err != nil {
 		return nil, err
 	}
@@ -107,43 +264,124 @@ func (f *Factory) Client() (client.Client, error) {
 		return nil, err
 	}
 
-	cache, err := cache.New(config, cache.Options{Scheme: scheme})
+	return f.configClient(config, scheme)
+}
+
+// ConfigClient returns a client for a cluster other than a management cluster
+// e.g. a region's kubernetes cluster or a virtual cluster.
+func (f *Factory) ConfigClient(config *rest.Config) (client.Client, error) {
+	scheme, err := getScheme()
 	if err != nil {
 		return nil, err
 	}
 
-	go func() {
-		_ = cache.Start(ctx)
-	}()
+	return f.configClient(config, scheme)
+}
+
+// configClient returns a client whose API calls are bounded by the request
+// timeout, as all clients should be.
+func (f *Factory) configClient(config *rest.Config, scheme *runtime.Scheme) (client.Client, error) {
+	client, err := client.New(config, client.Options{Scheme: scheme})
+	if err != nil {
+		return nil, err
+	}
+
+	return &timeoutClient{Client: client, timeout: f.UnikornFlags.RequestTimeout}, nil
+}
+
+func (f *Factory) ProfileNameCompletionFunc() func(*cobra.Command, []string, string) ([]string, cobra.ShellCompDirective) {
+	return func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		config, err := profile.Load()
+		if err != nil {
+			return nil, cobra.ShellCompDirectiveError
+		}
+
+		names := make([]string, 0, len(config.Profiles))
+
+		for name := range config.Profiles {
+			names = append(names, name)
+		}
+
+		slices.Sort(names)
+
+		return names, cobra.ShellCompDirectiveNoFileComp
+	}
+}
 
-	cache.WaitForCacheSync(ctx)
+// Cache returns an informer cache for long running commands e.g. watches, that
+// need to be notified of changes to resources.  Only the type of the object is
+// cached, limited to the namespace, or all namespaces if empty, and resources that
+// match the selector.  The cache runs until the context is cancelled.
+func (f *Factory) Cache(ctx context.Context, object client.Object, namespace string, selector labels.Selector) (cache.Cache, error) {
+	config, err := f.RESTConfig()
+	if err != nil {
+		return nil, err
+	}
 
-	options := client.Options{
+	scheme, err := getScheme()
+	if err != nil {
+		return nil, err
+	}
+
+	byObject := cache.ByObject{
+		Label: selector,
+	}
+
+	if namespace != "" {
+		byObject.Namespaces = map[string]cache.Config{
+			namespace: {},
+		}
+	}
+
+	options := cache.Options{
 		Scheme: scheme,
-		Cache: &client.CacheOptions{
-			Reader:       cache,
-			Unstructured: false,
+		ByObject: map[client.Object]cache.ByObject{
+			object: byObject,
 		},
+		ReaderFailOnMissingInformer: true,
 	}
 
-	client, err := client.New(config, options)
+	c, err := cache.New(config, options)
 	if err != nil {
 		return nil, err
 	}
 
-	return client, nil
+	if _, err := c.GetInformer(ctx, object); err != nil {
+		return nil, err
+	}
+
+	go func() {
+		_ = c.Start(ctx)
+	}()
+
+	if !c.WaitForCacheSync(ctx) {
+		return nil, fmt.Errorf("%w: cache failed to sync", errors.ErrResource)
+	}
+
+	return c, nil
+}
+
+func (f *Factory) ContextNameCompletionFunc() func(*cobra.Command, []string, string) ([]string, cobra.ShellCompDirective) {
+	return func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		names, err := f.Contexts()
+		if err != nil {
+			return nil, cobra.ShellCompDirectiveError
+		}
+
+		return names, cobra.ShellCompDirectiveNoFileComp
+	}
 }
 
 func (f *Factory) NamespaceCompletionFunc() func(*cobra.Command, []string, string) ([]string, cobra.ShellCompDirective) {
 	return func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
-		c, err := f.Client()
+		c, err := f.Client(cmd.Context())
 		if err != nil {
 			return nil, cobra.ShellCompDirectiveError
 		}
 
 		resources := &corev1.NamespaceList{}
 
-		if err := c.List(context.Background(), resources, &client.ListOptions{}); err != nil {
+		if err := c.List(cmd.Context(), resources, &client.ListOptions{}); err != nil {
 			return nil, cobra.ShellCompDirectiveError
 		}
 
@@ -159,14 +397,14 @@ func (f *Factory) NamespaceCompletionFunc() func(*cobra.Command, []string, strin
 
 func (f *Factory) RegionNameCompletionFunc() func(*cobra.Command, []string, string) ([]string, cobra.ShellCompDirective) {
 	return func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
-		c, err := f.Client()
+		c, err := f.Client(cmd.Context())
 		if err != nil {
 			return nil, cobra.ShellCompDirectiveError
 		}
 
 		resources := &regionv1.RegionList{}
 
-		if err := c.List(context.Background(), resources, &client.ListOptions{Namespace: f.UnikornFlags.RegionNamespace}); err != nil {
+		if err := c.List(cmd.Context(), resources, &client.ListOptions{Namespace: f.UnikornFlags.RegionNamespace}); err != nil {
 			return nil, cobra.ShellCompDirectiveError
 		}
 
@@ -182,14 +420,14 @@ func (f *Factory) RegionNameCompletionFunc() func(*cobra.Command, []string, stri
 
 func (f *Factory) OrganizationNameCompletionFunc() func(*cobra.Command, []string, string) ([]string, cobra.ShellCompDirective) {
 	return func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
-		c, err := f.Client()
+		c, err := f.Client(cmd.Context())
 		if err != nil {
 			return nil, cobra.ShellCompDirectiveError
 		}
 
 		resources := &identityv1.OrganizationList{}
 
-		if err := c.List(context.Background(), resources, &client.ListOptions{Namespace: f.UnikornFlags.IdentityNamespace}); err != nil {
+		if err := c.List(cmd.Context(), resources, &client.ListOptions{Namespace: f.UnikornFlags.IdentityNamespace}); err != nil {
 			return nil, cobra.ShellCompDirectiveError
 		}
 
@@ -205,7 +443,7 @@ func (f *Factory) OrganizationNameCompletionFunc() func(*cobra.Command, []string
 
 func (f *Factory) ProjectNameCompletionFunc(organizationName *string) func(*cobra.Command, []string, string) ([]string, cobra.ShellCompDirective) {
 	return func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
-		c, err := f.Client()
+		c, err := f.Client(cmd.Context())
 		if err != nil {
 			return nil, cobra.ShellCompDirectiveError
 		}
@@ -213,7 +451,7 @@ func (f *Factory) ProjectNameCompletionFunc(organizationName *string) func(*cobr
 		options := &client.ListOptions{}
 
 		if organizationName != nil && *organizationName != "" {
-			organization, err := util.GetOrganization(context.Background(), c, f.UnikornFlags.IdentityNamespace, *organizationName)
+			organization, err := resolve.Organization(cmd.Context(), c, &resolve.Scope{IdentityNamespace: f.UnikornFlags.IdentityNamespace}, *organizationName)
 			if err != nil {
 				return nil, cobra.ShellCompDirectiveError
 			}
@@ -225,7 +463,43 @@ func (f *Factory) ProjectNameCompletionFunc(organizationName *string) func(*cobr
 
 		resources := &identityv1.ProjectList{}
 
-		if err := c.List(context.Background(), resources, options); err != nil {
+		if err := c.List(cmd.Context(), resources, options); err != nil {
+			return nil, cobra.ShellCompDirectiveError
+		}
+
+		names := make([]string, len(resources.Items))
+
+		for i := range resources.Items {
+			names[i] = resources.Items[i].Labels[constants.NameLabel]
+		}
+
+		return names, cobra.ShellCompDirectiveNoFileComp
+	}
+}
+
+func (f *Factory) GroupNameCompletionFunc(organizationName *string) func(*cobra.Command, []string, string) ([]string, cobra.ShellCompDirective) {
+	return func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		c, err := f.Client(cmd.Context())
+		if err != nil {
+			return nil, cobra.ShellCompDirectiveError
+		}
+
+		options := &client.ListOptions{}
+
+		if organizationName != nil && *organizationName != "" {
+			organization, err := resolve.Organization(cmd.Context(), c, &resolve.Scope{IdentityNamespace: f.UnikornFlags.IdentityNamespace}, *organizationName)
+			if err != nil {
+				return nil, cobra.ShellCompDirectiveError
+			}
+
+			options.LabelSelector = labels.SelectorFromSet(labels.Set{
+				constants.OrganizationLabel: organization.Name,
+			})
+		}
+
+		resources := &identityv1.GroupList{}
+
+		if err := c.List(cmd.Context(), resources, options); err != nil {
 			return nil, cobra.ShellCompDirectiveError
 		}
 
@@ -241,7 +515,7 @@ func (f *Factory) ProjectNameCompletionFunc(organizationName *string) func(*cobr
 
 func (f *Factory) KubernetesClusterNameCompletionFunc(organizationName, projectName *string) func(*cobra.Command, []string, string) ([]string, cobra.ShellCompDirective) {
 	return func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
-		c, err := f.Client()
+		c, err := f.Client(cmd.Context())
 		if err != nil {
 			return nil, cobra.ShellCompDirectiveError
 		}
@@ -249,7 +523,7 @@ func (f *Factory) KubernetesClusterNameCompletionFunc(organizationName, projectN
 		l := labels.Set{}
 
 		if organizationName != nil && *organizationName != "" {
-			organization, err := util.GetOrganization(context.Background(), c, f.UnikornFlags.IdentityNamespace, *organizationName)
+			organization, err := resolve.Organization(cmd.Context(), c, &resolve.Scope{IdentityNamespace: f.UnikornFlags.IdentityNamespace}, *organizationName)
 			if err != nil {
 				return nil, cobra.ShellCompDirectiveError
 			}
@@ -258,7 +532,7 @@ func (f *Factory) KubernetesClusterNameCompletionFunc(organizationName, projectN
 		}
 
 		if projectName != nil && *projectName != "" {
-			project, err := util.GetProject(context.Background(), c, l[constants.OrganizationLabel], *projectName)
+			project, err := resolve.Project(cmd.Context(), c, &resolve.Scope{IdentityNamespace: f.UnikornFlags.IdentityNamespace, OrganizationID: l[constants.OrganizationLabel]}, *projectName)
 			if err != nil {
 				return nil, cobra.ShellCompDirectiveError
 			}
@@ -272,7 +546,7 @@ func (f *Factory) KubernetesClusterNameCompletionFunc(organizationName, projectN
 
 		resources := &kubernetesv1.KubernetesClusterList{}
 
-		if err := c.List(context.Background(), resources, options); err != nil {
+		if err := c.List(cmd.Context(), resources, options); err != nil {
 			return nil, cobra.ShellCompDirectiveError
 		}
 
@@ -288,7 +562,7 @@ func (f *Factory) KubernetesClusterNameCompletionFunc(organizationName, projectN
 
 func (f *Factory) VirtualKubernetesClusterNameCompletionFunc(organizationName, projectName *string) func(*cobra.Command, []string, string) ([]string, cobra.ShellCompDirective) {
 	return func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
-		c, err := f.Client()
+		c, err := f.Client(cmd.Context())
 		if err != nil {
 			return nil, cobra.ShellCompDirectiveError
 		}
@@ -296,7 +570,7 @@ func (f *Factory) VirtualKubernetesClusterNameCompletionFunc(organizationName, p
 		l := labels.Set{}
 
 		if organizationName != nil && *organizationName != "" {
-			organization, err := util.GetOrganization(context.Background(), c, f.UnikornFlags.IdentityNamespace, *organizationName)
+			organization, err := resolve.Organization(cmd.Context(), c, &resolve.Scope{IdentityNamespace: f.UnikornFlags.IdentityNamespace}, *organizationName)
 			if err != nil {
 				return nil, cobra.ShellCompDirectiveError
 			}
@@ -305,7 +579,7 @@ func (f *Factory) VirtualKubernetesClusterNameCompletionFunc(organizationName, p
 		}
 
 		if projectName != nil && *projectName != "" {
-			project, err := util.GetProject(context.Background(), c, l[constants.OrganizationLabel], *projectName)
+			project, err := resolve.Project(cmd.Context(), c, &resolve.Scope{IdentityNamespace: f.UnikornFlags.IdentityNamespace, OrganizationID: l[constants.OrganizationLabel]}, *projectName)
 			if err != nil {
 				return nil, cobra.ShellCompDirectiveError
 			}
@@ -319,7 +593,55 @@ func (f *Factory) VirtualKubernetesClusterNameCompletionFunc(organizationName, p
 
 		resources := &kubernetesv1.VirtualKubernetesClusterList{}
 
-		if err := c.List(context.Background(), resources, options); err != nil {
+		if err := c.List(cmd.Context(), resources, options); err != nil {
+			return nil, cobra.ShellCompDirectiveError
+		}
+
+		names := make([]string, len(resources.Items))
+
+		for i := range resources.Items {
+			names[i] = resources.Items[i].Labels[constants.NameLabel]
+		}
+
+		return names, cobra.ShellCompDirectiveNoFileComp
+	}
+}
+
+func (f *Factory) NetworkNameCompletionFunc(organizationName, projectName *string) func(*cobra.Command, []string, string) ([]string, cobra.ShellCompDirective) {
+	return func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		c, err := f.Client(cmd.Context())
+		if err != nil {
+			return nil, cobra.ShellCompDirectiveError
+		}
+
+		l := labels.Set{}
+
+		if organizationName != nil && *organizationName != "" {
+			organization, err := resolve.Organization(cmd.Context(), c, &resolve.Scope{IdentityNamespace: f.UnikornFlags.IdentityNamespace}, *organizationName)
+			if err != nil {
+				return nil, cobra.ShellCompDirectiveError
+			}
+
+			l[constants.OrganizationLabel] = organization.Name
+		}
+
+		if projectName != nil && *projectName != "" {
+			project, err := resolve.Project(cmd.Context(), c, &resolve.Scope{IdentityNamespace: f.UnikornFlags.IdentityNamespace, OrganizationID: l[constants.OrganizationLabel]}, *projectName)
+			if err != nil {
+				return nil, cobra.ShellCompDirectiveError
+			}
+
+			l[constants.ProjectLabel] = project.Name
+		}
+
+		options := &client.ListOptions{
+			Namespace:     f.UnikornFlags.RegionNamespace,
+			LabelSelector: labels.SelectorFromSet(l),
+		}
+
+		resources := &regionv1.NetworkList{}
+
+		if err := c.List(cmd.Context(), resources, options); err != nil {
 			return nil, cobra.ShellCompDirectiveError
 		}
 
@@ -335,14 +657,14 @@ func (f *Factory) VirtualKubernetesClusterNameCompletionFunc(organizationName, p
 
 func (f *Factory) RoleNameCompletionFunc() func(*cobra.Command, []string, string) ([]string, cobra.ShellCompDirective) {
 	return func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
-		c, err := f.Client()
+		c, err := f.Client(cmd.Context())
 		if err != nil {
 			return nil, cobra.ShellCompDirectiveError
 		}
 
 		resources := &identityv1.RoleList{}
 
-		if err := c.List(context.Background(), resources, &client.ListOptions{Namespace: f.UnikornFlags.IdentityNamespace}); err != nil {
+		if err := c.List(cmd.Context(), resources, &client.ListOptions{Namespace: f.UnikornFlags.IdentityNamespace}); err != nil {
 			return nil, cobra.ShellCompDirectiveError
 		}
 
@@ -362,14 +684,14 @@ func (f *Factory) RoleNameCompletionFunc() func(*cobra.Command, []string, string
 
 func (f *Factory) UserSubjectCompletionFunc() func(*cobra.Command, []string, string) ([]string, cobra.ShellCompDirective) {
 	return func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
-		c, err := f.Client()
+		c, err := f.Client(cmd.Context())
 		if err != nil {
 			return nil, cobra.ShellCompDirectiveError
 		}
 
 		resources := &identityv1.UserList{}
 
-		if err := c.List(context.Background(), resources, &client.ListOptions{Namespace: f.UnikornFlags.IdentityNamespace}); err != nil {
+		if err := c.List(cmd.Context(), resources, &client.ListOptions{Namespace: f.UnikornFlags.IdentityNamespace}); err != nil {
 			return nil, cobra.ShellCompDirectiveError
 		}
 
@@ -0,0 +1,140 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package factory
+
+import (
+	"context"
+	goerrors "errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/nscaledev/unicli/pkg/errors"
+
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+)
+
+// timeoutClient bounds each API call by the request timeout, rather than the
+// command as a whole, so long running operations e.g. listing a large fleet or
+// waiting for a resource aren't cut short.  When a call times out the error names
+// it so it's obvious what was slow.
+type timeoutClient struct {
+	client.Client
+
+	timeout time.Duration
+}
+
+// call runs an API call, bounded by the timeout if set.
+func (c *timeoutClient) call(ctx context.Context, verb string, object runtime.Object, f func(context.Context) error) error {
+	if c.timeout == 0 {
+		return f(ctx)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	err := f(ctx)
+	if err == nil || !goerrors.Is(ctx.Err(), context.DeadlineExceeded) {
+		return err
+	}
+
+	kind := "resource"
+
+	if gvk, err := apiutil.GVKForObject(object, c.Scheme()); err == nil {
+		kind = strings.ToLower(strings.TrimSuffix(gvk.Kind, "List"))
+	}
+
+	return fmt.Errorf("%w: %s %s did not complete within %v, consider increasing --request-timeout", errors.ErrRequestTimeout, verb, kind, c.timeout)
+}
+
+func (c *timeoutClient) Get(ctx context.Context, key client.ObjectKey, obj client.Object, opts ...client.GetOption) error {
+	return c.call(ctx, "get", obj, func(ctx context.Context) error {
+		return c.Client.Get(ctx, key, obj, opts...)
+	})
+}
+
+func (c *timeoutClient) List(ctx context.Context, list client.ObjectList, opts ...client.ListOption) error {
+	return c.call(ctx, "list", list, func(ctx context.Context) error {
+		return c.Client.List(ctx, list, opts...)
+	})
+}
+
+func (c *timeoutClient) Create(ctx context.Context, obj client.Object, opts ...client.CreateOption) error {
+	return c.call(ctx, "create", obj, func(ctx context.Context) error {
+		return c.Client.Create(ctx, obj, opts...)
+	})
+}
+
+func (c *timeoutClient) Update(ctx context.Context, obj client.Object, opts ...client.UpdateOption) error {
+	return c.call(ctx, "update", obj, func(ctx context.Context) error {
+		return c.Client.Update(ctx, obj, opts...)
+	})
+}
+
+func (c *timeoutClient) Patch(ctx context.Context, obj client.Object, patch client.Patch, opts ...client.PatchOption) error {
+	return c.call(ctx, "patch", obj, func(ctx context.Context) error {
+		return c.Client.Patch(ctx, obj, patch, opts...)
+	})
+}
+
+func (c *timeoutClient) Delete(ctx context.Context, obj client.Object, opts ...client.DeleteOption) error {
+	return c.call(ctx, "delete", obj, func(ctx context.Context) error {
+		return c.Client.Delete(ctx, obj, opts...)
+	})
+}
+
+func (c *timeoutClient) DeleteAllOf(ctx context.Context, obj client.Object, opts ...client.DeleteAllOfOption) error {
+	return c.call(ctx, "delete", obj, func(ctx context.Context) error {
+		return c.Client.DeleteAllOf(ctx, obj, opts...)
+	})
+}
+
+func (c *timeoutClient) Status() client.SubResourceWriter {
+	return &timeoutStatusWriter{
+		SubResourceWriter: c.Client.Status(),
+		client:            c,
+	}
+}
+
+// timeoutStatusWriter bounds status updates by the request timeout, just like
+// any other write.
+type timeoutStatusWriter struct {
+	client.SubResourceWriter
+
+	client *timeoutClient
+}
+
+func (w *timeoutStatusWriter) Create(ctx context.Context, obj client.Object, subResource client.Object, opts ...client.SubResourceCreateOption) error {
+	return w.client.call(ctx, "create status", obj, func(ctx context.Context) error {
+		return w.SubResourceWriter.Create(ctx, obj, subResource, opts...)
+	})
+}
+
+func (w *timeoutStatusWriter) Update(ctx context.Context, obj client.Object, opts ...client.SubResourceUpdateOption) error {
+	return w.client.call(ctx, "update status", obj, func(ctx context.Context) error {
+		return w.SubResourceWriter.Update(ctx, obj, opts...)
+	})
+}
+
+func (w *timeoutStatusWriter) Patch(ctx context.Context, obj client.Object, patch client.Patch, opts ...client.SubResourcePatchOption) error {
+	return w.client.call(ctx, "patch status", obj, func(ctx context.Context) error {
+		return w.SubResourceWriter.Patch(ctx, obj, patch, opts...)
+	})
+}
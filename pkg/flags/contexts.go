@@ -0,0 +1,148 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package flags
+
+import (
+	"context"
+	goerrors "errors"
+	"fmt"
+	"os"
+	"slices"
+	"sync"
+
+	"github.com/spf13/cobra"
+
+	"github.com/nscaledev/unicli/pkg/errors"
+	"github.com/nscaledev/unicli/pkg/factory"
+	"github.com/nscaledev/unicli/pkg/printer"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// ListFunc lists resources from a single management cluster.
+type ListFunc func(context.Context, client.Client) (*printer.Listing, error)
+
+// ContextsFlags are common to list commands, and allow resources to be listed
+// from multiple management clusters at once.
+type ContextsFlags struct {
+	AllContexts bool
+	Contexts    []string
+}
+
+func (f *ContextsFlags) AddFlags(cmd *cobra.Command, factory *factory.Factory) error {
+	cmd.Flags().BoolVar(&f.AllContexts, "all-contexts", false, "List resources from the management clusters of all contexts in the Kubernetes configuration.")
+	cmd.Flags().StringSliceVar(&f.Contexts, "contexts", nil, "List resources from the management clusters of the specified contexts.")
+
+	cmd.MarkFlagsMutuallyExclusive("all-contexts", "contexts")
+
+	if err := cmd.RegisterFlagCompletionFunc("contexts", factory.ContextNameCompletionFunc()); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Enabled returns true if resources are to be listed from multiple management
+// clusters.
+func (f *ContextsFlags) Enabled() bool {
+	return f.AllContexts || len(f.Contexts) != 0
+}
+
+// Validate checks the flags don't conflict with the single management cluster
+// selected by --context, or with watching, which is limited to one cluster.  A
+// context from the profile is only a default, so is overridden.
+func (f *ContextsFlags) Validate(cmd *cobra.Command, factory *factory.Factory, watch *WatchFlags) error {
+	if !f.Enabled() {
+		return nil
+	}
+
+	if factory.Specified(cmd, "context") {
+		return fmt.Errorf("%w: --context cannot be used with --all-contexts or --contexts", errors.ErrValidation)
+	}
+
+	if watch != nil && watch.Watch {
+		return fmt.Errorf("%w: --watch cannot be used with --all-contexts or --contexts", errors.ErrValidation)
+	}
+
+	return nil
+}
+
+func (f *ContextsFlags) names(factory *factory.Factory) ([]string, error) {
+	names, err := factory.Contexts()
+	if err != nil {
+		return nil, err
+	}
+
+	if f.AllContexts {
+		if len(names) == 0 {
+			return nil, fmt.Errorf("%w: no contexts found in the Kubernetes configuration", errors.ErrValidation)
+		}
+
+		return names, nil
+	}
+
+	for _, name := range f.Contexts {
+		if !slices.Contains(names, name) {
+			return nil, fmt.Errorf("%w: context %s not found in the Kubernetes configuration", errors.ErrValidation, name)
+		}
+	}
+
+	return f.Contexts, nil
+}
+
+// Run lists resources from each selected management cluster concurrently, and
+// prints the combined results with the cluster each resource belongs to.  The
+// list function is called once per cluster, so must not share mutable state.
+// Errors from individual clusters are reported, but don't prevent the results
+// from the others being printed.
+func (f *ContextsFlags) Run(ctx context.Context, factory *factory.Factory, list ListFunc) error {
+	names, err := f.names(factory)
+	if err != nil {
+		return err
+	}
+
+	listings := make([]*printer.Listing, len(names))
+	errs := make([]error, len(names))
+
+	var wg sync.WaitGroup
+
+	for i, name := range names {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			cli, err := factory.ContextClient(ctx, name)
+			if err != nil {
+				errs[i] = fmt.Errorf("context %s: %w", name, err)
+				return
+			}
+
+			if listings[i], err = list(ctx, cli); err != nil {
+				errs[i] = fmt.Errorf("context %s: %w", name, err)
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	if err := factory.PrintFlags.PrintListing(os.Stdout, printer.MergeListings(names, listings)); err != nil {
+		return err
+	}
+
+	return goerrors.Join(errs...)
+}
@@ -0,0 +1,144 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package flags
+
+import (
+	"bufio"
+	"context"
+	goerrors "errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/nscaledev/unicli/pkg/errors"
+	"github.com/unikorn-cloud/core/pkg/util/retry"
+
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// DeleteFlags are common to all delete commands, and handle confirmation and
+// waiting for the resource to actually go away.
+type DeleteFlags struct {
+	Yes     bool
+	Wait    bool
+	Timeout time.Duration
+}
+
+func NewDeleteFlags() *DeleteFlags {
+	return &DeleteFlags{}
+}
+
+func (f *DeleteFlags) AddFlags(cmd *cobra.Command) {
+	cmd.Flags().BoolVarP(&f.Yes, "yes", "y", false, "Delete without asking for confirmation.")
+	cmd.Flags().BoolVar(&f.Wait, "wait", false, "Wait for finalizers to clear and the resource to be removed.")
+	cmd.Flags().DurationVar(&f.Timeout, "timeout", 10*time.Minute, "How long to wait for the resource to be removed when --wait is specified.")
+}
+
+// Confirm describes what will be deleted, including any dependent resources
+// that will be affected, and asks the user to confirm unless --yes was given.
+func (f *DeleteFlags) Confirm(kind, name, id string, dependents []string) error {
+	if f.Yes {
+		return nil
+	}
+
+	return confirm(os.Stdin, os.Stdout, kind, name, id, dependents)
+}
+
+func confirm(in io.Reader, out io.Writer, kind, name, id string, dependents []string) error {
+	fmt.Fprintf(out, "The following %s will be deleted:\n  %s (%s)\n", kind, name, id)
+
+	if len(dependents) > 0 {
+		fmt.Fprintln(out, "The following dependent resources will be affected:")
+
+		for _, dependent := range dependents {
+			fmt.Fprintf(out, "  %s\n", dependent)
+		}
+	}
+
+	ok, err := prompt(in, out)
+	if err != nil {
+		return err
+	}
+
+	if !ok {
+		return fmt.Errorf("%w: deletion of %s %s not confirmed", errors.ErrAborted, kind, name)
+	}
+
+	return nil
+}
+
+// prompt asks the user whether to continue, returning true if they agree.
+func prompt(in io.Reader, out io.Writer) (bool, error) {
+	fmt.Fprint(out, "Continue? [y/N]: ")
+
+	answer, err := bufio.NewReader(in).ReadString('\n')
+	if err != nil && !goerrors.Is(err, io.EOF) {
+		return false, err
+	}
+
+	switch strings.ToLower(strings.TrimSpace(answer)) {
+	case "y", "yes":
+		return true, nil
+	}
+
+	return false, nil
+}
+
+// Delete deletes the resource, optionally waiting for any finalizers to
+// clear and the resource to be removed from the API.
+func (f *DeleteFlags) Delete(ctx context.Context, cli client.Client, kind, name string, resource client.Object, opts ...client.DeleteOption) error {
+	if err := cli.Delete(ctx, resource, opts...); err != nil {
+		return err
+	}
+
+	if !f.Wait {
+		fmt.Printf("%s %s deleted\n", kind, name)
+
+		return nil
+	}
+
+	// Individual API calls are bounded by the request timeout, deprovisioning
+	// as a whole by our own.
+	waitCtx, cancel := context.WithTimeout(ctx, f.Timeout)
+	defer cancel()
+
+	callback := func() error {
+		if err := cli.Get(waitCtx, client.ObjectKeyFromObject(resource), resource); err != nil {
+			if kerrors.IsNotFound(err) {
+				return nil
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("%w: %s %s still exists with finalizers %s", errors.ErrResource, kind, name, strings.Join(resource.GetFinalizers(), ", "))
+	}
+
+	if err := retry.Forever().DoWithContext(waitCtx, callback); err != nil {
+		return err
+	}
+
+	fmt.Printf("%s %s deleted\n", kind, name)
+
+	return nil
+}
@@ -0,0 +1,97 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package flags
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/nscaledev/unicli/pkg/errors"
+
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// EditFlags are common to edit commands, and handle confirmation of changes.
+type EditFlags struct {
+	Yes bool
+}
+
+func NewEditFlags() *EditFlags {
+	return &EditFlags{}
+}
+
+func (f *EditFlags) AddFlags(cmd *cobra.Command) {
+	cmd.Flags().BoolVarP(&f.Yes, "yes", "y", false, "Apply changes without asking for confirmation.")
+}
+
+// Confirm describes the changes that will be made to a resource, and asks the
+// user to confirm unless --yes was given.
+func (f *EditFlags) Confirm(kind, name, id string, changes []string) error {
+	if f.Yes {
+		printChanges(os.Stdout, kind, name, id, changes)
+
+		return nil
+	}
+
+	return confirmChanges(os.Stdin, os.Stdout, kind, name, id, changes)
+}
+
+func printChanges(out io.Writer, kind, name, id string, changes []string) {
+	fmt.Fprintf(out, "The following changes will be made to %s %s (%s):\n", kind, name, id)
+
+	for _, change := range changes {
+		fmt.Fprintf(out, "  %s\n", change)
+	}
+}
+
+func confirmChanges(in io.Reader, out io.Writer, kind, name, id string, changes []string) error {
+	printChanges(out, kind, name, id, changes)
+
+	ok, err := prompt(in, out)
+	if err != nil {
+		return err
+	}
+
+	if !ok {
+		return fmt.Errorf("%w: changes to %s %s not confirmed", errors.ErrAborted, kind, name)
+	}
+
+	return nil
+}
+
+// Patch applies the changes made to a copy of the original resource.  The
+// resource version is checked, so changes made by someone else since the
+// original was read are not silently overwritten.
+func (f *EditFlags) Patch(ctx context.Context, cli client.Client, kind, name string, original, updated client.Object) error {
+	if err := cli.Patch(ctx, updated, client.MergeFromWithOptions(original, client.MergeFromWithOptimisticLock{})); err != nil {
+		if kerrors.IsConflict(err) {
+			return fmt.Errorf("%w: %s %s was modified by someone else, please try again", errors.ErrResource, kind, name)
+		}
+
+		return err
+	}
+
+	fmt.Printf("%s %s updated\n", kind, name)
+
+	return nil
+}
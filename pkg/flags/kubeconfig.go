@@ -0,0 +1,79 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package flags
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/spf13/cobra"
+
+	"github.com/nscaledev/unicli/pkg/kubeconfig"
+
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+)
+
+// KubeconfigFlags are common to commands that retrieve cluster credentials, and
+// select whether they are printed, written to a file or merged into the user's
+// kubernetes configuration.
+type KubeconfigFlags struct {
+	File  string
+	Merge bool
+}
+
+func NewKubeconfigFlags(merge bool) *KubeconfigFlags {
+	return &KubeconfigFlags{
+		Merge: merge,
+	}
+}
+
+func (f *KubeconfigFlags) AddFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(&f.File, "file", "", "Write the kubeconfig to the specified file.")
+	cmd.Flags().BoolVar(&f.Merge, "merge", f.Merge, "Merge the kubeconfig into ~/.kube/config.")
+
+	cmd.MarkFlagsMutuallyExclusive("file", "merge")
+}
+
+// Output saves the configuration as requested, otherwise prints it.
+func (f *KubeconfigFlags) Output(w io.Writer, config *clientcmdapi.Config) error {
+	switch {
+	case f.File != "":
+		if err := kubeconfig.Write(f.File, config); err != nil {
+			return err
+		}
+
+		fmt.Fprintf(w, "kubeconfig written to %s\n", f.File)
+	case f.Merge:
+		if err := kubeconfig.Merge(clientcmd.RecommendedHomeFile, config); err != nil {
+			return err
+		}
+
+		fmt.Fprintf(w, "context %s added to %s\n", config.CurrentContext, clientcmd.RecommendedHomeFile)
+	default:
+		data, err := clientcmd.Write(*config)
+		if err != nil {
+			return err
+		}
+
+		if _, err := w.Write(data); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
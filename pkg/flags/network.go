@@ -0,0 +1,92 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package flags
+
+import (
+	"context"
+
+	"github.com/spf13/cobra"
+
+	"github.com/nscaledev/unicli/pkg/factory"
+	"github.com/nscaledev/unicli/pkg/resolve"
+	regionv1 "github.com/unikorn-cloud/region/pkg/apis/unikorn/v1alpha1"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+type NetworkFlags struct {
+	unikornFlags      *factory.UnikornFlags
+	organizationFlags *OrganizationFlags
+	projectFlags      *ProjectFlags
+
+	NetworkName string
+
+	Network *regionv1.Network
+}
+
+func NewNetworkFlags(unikornFlags *factory.UnikornFlags, organizationFlags *OrganizationFlags, projectFlags *ProjectFlags) *NetworkFlags {
+	return &NetworkFlags{
+		unikornFlags:      unikornFlags,
+		organizationFlags: organizationFlags,
+		projectFlags:      projectFlags,
+	}
+}
+
+// Clone returns an unresolved copy of the flags, scoped to the cloned organization
+// and project flags, so they can be validated against another management cluster.
+func (f *NetworkFlags) Clone(organizationFlags *OrganizationFlags, projectFlags *ProjectFlags) *NetworkFlags {
+	return &NetworkFlags{
+		unikornFlags:      f.unikornFlags,
+		organizationFlags: organizationFlags,
+		projectFlags:      projectFlags,
+		NetworkName:       f.NetworkName,
+	}
+}
+
+func (f *NetworkFlags) AddFlags(cmd *cobra.Command, factory *factory.Factory, required bool) error {
+	cmd.Flags().StringVar(&f.NetworkName, "network", "", "Network name or ID")
+
+	if required {
+		if err := cmd.MarkFlagRequired("network"); err != nil {
+			return err
+		}
+	}
+
+	if err := cmd.RegisterFlagCompletionFunc("network", factory.NetworkNameCompletionFunc(&f.organizationFlags.OrganizationName, &f.projectFlags.ProjectName)); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (f *NetworkFlags) Validate(ctx context.Context, cli client.Client) error {
+	if f.NetworkName == "" {
+		return nil
+	}
+
+	scope := Scope(f.unikornFlags, f.organizationFlags, f.projectFlags)
+	scope.Namespace = f.unikornFlags.RegionNamespace
+
+	network, err := resolve.Network(ctx, cli, scope, f.NetworkName)
+	if err != nil {
+		return err
+	}
+
+	f.Network = network
+
+	return nil
+}
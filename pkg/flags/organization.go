@@ -18,11 +18,13 @@ package flags
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/spf13/cobra"
 
+	"github.com/nscaledev/unicli/pkg/errors"
 	"github.com/nscaledev/unicli/pkg/factory"
-	"github.com/nscaledev/unicli/pkg/util"
+	"github.com/nscaledev/unicli/pkg/resolve"
 	identityv1 "github.com/unikorn-cloud/identity/pkg/apis/unikorn/v1alpha1"
 
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -42,6 +44,15 @@ func NewOrganizationFlags(unikornFlags *factory.UnikornFlags) *OrganizationFlags
 	}
 }
 
+// Clone returns an unresolved copy of the flags, so they can be validated
+// against another management cluster.
+func (f *OrganizationFlags) Clone() *OrganizationFlags {
+	return &OrganizationFlags{
+		unikornFlags:     f.unikornFlags,
+		OrganizationName: f.OrganizationName,
+	}
+}
+
 func (f *OrganizationFlags) AddFlags(cmd *cobra.Command, factory *factory.Factory, required bool) error {
 	cmd.Flags().StringVar(&f.OrganizationName, "organization", "", "Organization name")
 
@@ -51,6 +62,8 @@ func (f *OrganizationFlags) AddFlags(cmd *cobra.Command, factory *factory.Factor
 		}
 	}
 
+	factory.ProfileDefault(cmd.Flags(), "organization")
+
 	if err := cmd.RegisterFlagCompletionFunc("organization", factory.OrganizationNameCompletionFunc()); err != nil {
 		return err
 	}
@@ -63,11 +76,15 @@ func (f *OrganizationFlags) Validate(ctx context.Context, cli client.Client) err
 		return nil
 	}
 
-	organization, err := util.GetOrganization(ctx, cli, f.unikornFlags.IdentityNamespace, f.OrganizationName)
+	organization, err := resolve.Organization(ctx, cli, Scope(f.unikornFlags, nil, nil), f.OrganizationName)
 	if err != nil {
 		return err
 	}
 
+	if organization.Status.Namespace == "" {
+		return fmt.Errorf("%w: organization %s is not provisioned", errors.ErrValidation, f.OrganizationName)
+	}
+
 	f.Organization = organization
 
 	return nil
@@ -18,11 +18,13 @@ package flags
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/spf13/cobra"
 
+	"github.com/nscaledev/unicli/pkg/errors"
 	"github.com/nscaledev/unicli/pkg/factory"
-	"github.com/nscaledev/unicli/pkg/util"
+	"github.com/nscaledev/unicli/pkg/resolve"
 	identityv1 "github.com/unikorn-cloud/identity/pkg/apis/unikorn/v1alpha1"
 
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -44,6 +46,16 @@ func NewProjectFlags(unikornFlags *factory.UnikornFlags, organizationFlags *Orga
 	}
 }
 
+// Clone returns an unresolved copy of the flags, scoped to the cloned organization
+// flags, so they can be validated against another management cluster.
+func (f *ProjectFlags) Clone(organizationFlags *OrganizationFlags) *ProjectFlags {
+	return &ProjectFlags{
+		unikornFlags:      f.unikornFlags,
+		organizationFlags: organizationFlags,
+		ProjectName:       f.ProjectName,
+	}
+}
+
 func (f *ProjectFlags) AddFlags(cmd *cobra.Command, factory *factory.Factory, required bool) error {
 	cmd.Flags().StringVar(&f.ProjectName, "project", "", "Project name")
 
@@ -53,6 +65,8 @@ func (f *ProjectFlags) AddFlags(cmd *cobra.Command, factory *factory.Factory, re
 		}
 	}
 
+	factory.ProfileDefault(cmd.Flags(), "project")
+
 	if err := cmd.RegisterFlagCompletionFunc("project", factory.ProjectNameCompletionFunc(&f.organizationFlags.OrganizationName)); err != nil {
 		return err
 	}
@@ -65,17 +79,15 @@ func (f *ProjectFlags) Validate(ctx context.Context, cli client.Client) error {
 		return nil
 	}
 
-	var organizationID string
-
-	if f.organizationFlags.Organization != nil {
-		organizationID = f.organizationFlags.Organization.Name
-	}
-
-	project, err := util.GetProject(ctx, cli, organizationID, f.ProjectName)
+	project, err := resolve.Project(ctx, cli, Scope(f.unikornFlags, f.organizationFlags, nil), f.ProjectName)
 	if err != nil {
 		return err
 	}
 
+	if project.Status.Namespace == "" {
+		return fmt.Errorf("%w: project %s is not provisioned", errors.ErrValidation, f.ProjectName)
+	}
+
 	f.Project = project
 
 	return nil
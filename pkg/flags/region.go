@@ -22,7 +22,7 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/nscaledev/unicli/pkg/factory"
-	"github.com/nscaledev/unicli/pkg/util"
+	"github.com/nscaledev/unicli/pkg/resolve"
 	regionv1 "github.com/unikorn-cloud/region/pkg/apis/unikorn/v1alpha1"
 
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -42,6 +42,15 @@ func NewRegionFlags(unikornFlags *factory.UnikornFlags) *RegionFlags {
 	}
 }
 
+// Clone returns an unresolved copy of the flags, so they can be validated
+// against another management cluster.
+func (f *RegionFlags) Clone() *RegionFlags {
+	return &RegionFlags{
+		unikornFlags: f.unikornFlags,
+		RegionName:   f.RegionName,
+	}
+}
+
 func (f *RegionFlags) AddFlags(cmd *cobra.Command, factory *factory.Factory, required bool) error {
 	cmd.Flags().StringVar(&f.RegionName, "region", "", "Region name")
 
@@ -63,7 +72,12 @@ func (f *RegionFlags) Validate(ctx context.Context, cli client.Client) error {
 		return nil
 	}
 
-	region, err := util.GetRegionByName(ctx, cli, f.unikornFlags.RegionNamespace, f.RegionName)
+	scope := &resolve.Scope{
+		IdentityNamespace: f.unikornFlags.IdentityNamespace,
+		Namespace:         f.unikornFlags.RegionNamespace,
+	}
+
+	region, err := resolve.Region(ctx, cli, scope, f.RegionName)
 	if err != nil {
 		return err
 	}
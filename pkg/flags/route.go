@@ -0,0 +1,97 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package flags
+
+import (
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/nscaledev/unicli/pkg/errors"
+	unikornv1core "github.com/unikorn-cloud/core/pkg/apis/unikorn/v1alpha1"
+	regionv1 "github.com/unikorn-cloud/region/pkg/apis/unikorn/v1alpha1"
+)
+
+// RoutesFlag is a repeatable flag that accumulates network routes in the form
+// prefix=10.0.0.0/8,nexthop=192.168.0.1.
+type RoutesFlag []regionv1.Route
+
+func (f *RoutesFlag) String() string {
+	if len(*f) == 0 {
+		return ""
+	}
+
+	routes := make([]string, len(*f))
+
+	for i, route := range *f {
+		routes[i] = fmt.Sprintf("prefix=%s,nexthop=%s", route.Prefix.String(), route.NextHop.String())
+	}
+
+	return "[" + strings.Join(routes, " ") + "]"
+}
+
+func (f *RoutesFlag) Type() string {
+	return "route"
+}
+
+func (f *RoutesFlag) Set(s string) error {
+	var (
+		prefix  *net.IPNet
+		nextHop net.IP
+	)
+
+	for field := range strings.SplitSeq(s, ",") {
+		key, value, ok := strings.Cut(field, "=")
+		if !ok {
+			return fmt.Errorf("%w: route field %q must be in the form key=value", errors.ErrValidation, field)
+		}
+
+		switch key {
+		case "prefix":
+			ip, ipnet, err := net.ParseCIDR(value)
+			if err != nil || ip.To4() == nil {
+				return fmt.Errorf("%w: route prefix %q is not a valid IPv4 prefix", errors.ErrValidation, value)
+			}
+
+			prefix = ipnet
+		case "nexthop":
+			ip := net.ParseIP(value)
+			if ip == nil || ip.To4() == nil {
+				return fmt.Errorf("%w: route next hop %q is not a valid IPv4 address", errors.ErrValidation, value)
+			}
+
+			nextHop = ip
+		default:
+			return fmt.Errorf("%w: unknown route field %q, expected one of prefix or nexthop", errors.ErrValidation, key)
+		}
+	}
+
+	if prefix == nil {
+		return fmt.Errorf("%w: route prefix must be specified", errors.ErrValidation)
+	}
+
+	if nextHop == nil {
+		return fmt.Errorf("%w: route next hop must be specified", errors.ErrValidation)
+	}
+
+	*f = append(*f, regionv1.Route{
+		Prefix:  unikornv1core.IPv4Prefix{IPNet: *prefix},
+		NextHop: unikornv1core.IPv4Address{IP: nextHop},
+	})
+
+	return nil
+}
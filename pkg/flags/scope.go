@@ -0,0 +1,41 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package flags
+
+import (
+	"github.com/nscaledev/unicli/pkg/factory"
+	"github.com/nscaledev/unicli/pkg/resolve"
+)
+
+// Scope returns where to resolve resources based on the organization and project
+// flags, either may be nil if the command doesn't accept them.
+func Scope(unikornFlags *factory.UnikornFlags, organizationFlags *OrganizationFlags, projectFlags *ProjectFlags) *resolve.Scope {
+	scope := &resolve.Scope{
+		IdentityNamespace: unikornFlags.IdentityNamespace,
+		ServiceNamespaces: unikornFlags.ServiceNamespaces(),
+	}
+
+	if organizationFlags != nil && organizationFlags.Organization != nil {
+		scope.OrganizationID = organizationFlags.Organization.Name
+	}
+
+	if projectFlags != nil && projectFlags.Project != nil {
+		scope.ProjectID = projectFlags.Project.Name
+	}
+
+	return scope
+}
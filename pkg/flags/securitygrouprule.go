@@ -0,0 +1,152 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package flags
+
+import (
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+
+	"github.com/nscaledev/unicli/pkg/errors"
+	"github.com/nscaledev/unicli/pkg/util"
+	unikornv1core "github.com/unikorn-cloud/core/pkg/apis/unikorn/v1alpha1"
+	regionv1 "github.com/unikorn-cloud/region/pkg/apis/unikorn/v1alpha1"
+)
+
+// SecurityGroupRulesFlag is a repeatable flag that accumulates security group
+// rules in the form direction=ingress,protocol=tcp,port=8000-8080,cidr=0.0.0.0/0.
+// The direction defaults to ingress, and omitting the port or CIDR leaves them
+// unrestricted.
+type SecurityGroupRulesFlag []regionv1.SecurityGroupRule
+
+func (f *SecurityGroupRulesFlag) String() string {
+	if len(*f) == 0 {
+		return ""
+	}
+
+	rules := make([]string, len(*f))
+
+	for i := range *f {
+		rules[i] = util.FormatSecurityGroupRule(&(*f)[i])
+	}
+
+	return "[" + strings.Join(rules, " ") + "]"
+}
+
+func (f *SecurityGroupRulesFlag) Type() string {
+	return "securityGroupRule"
+}
+
+func parsePort(value string) (int, error) {
+	port, err := strconv.Atoi(value)
+	if err != nil || port < 1 || port > 65535 {
+		return 0, fmt.Errorf("%w: security group rule port %q must be between 1 and 65535", errors.ErrValidation, value)
+	}
+
+	return port, nil
+}
+
+func parsePorts(value string) (*regionv1.SecurityGroupRulePort, error) {
+	first, last, isRange := strings.Cut(value, "-")
+
+	start, err := parsePort(first)
+	if err != nil {
+		return nil, err
+	}
+
+	if !isRange {
+		return &regionv1.SecurityGroupRulePort{
+			Number: &start,
+		}, nil
+	}
+
+	end, err := parsePort(last)
+	if err != nil {
+		return nil, err
+	}
+
+	if start >= end {
+		return nil, fmt.Errorf("%w: security group rule port range %q must have a start lower than its end", errors.ErrValidation, value)
+	}
+
+	return &regionv1.SecurityGroupRulePort{
+		Range: &regionv1.SecurityGroupRulePortRange{
+			Start: start,
+			End:   end,
+		},
+	}, nil
+}
+
+func (f *SecurityGroupRulesFlag) Set(s string) error {
+	rule := regionv1.SecurityGroupRule{
+		Direction: regionv1.Ingress,
+	}
+
+	for field := range strings.SplitSeq(s, ",") {
+		key, value, ok := strings.Cut(field, "=")
+		if !ok {
+			return fmt.Errorf("%w: security group rule field %q must be in the form key=value", errors.ErrValidation, field)
+		}
+
+		switch key {
+		case "direction":
+			switch direction := regionv1.SecurityGroupRuleDirection(value); direction {
+			case regionv1.Ingress, regionv1.Egress:
+				rule.Direction = direction
+			default:
+				return fmt.Errorf("%w: security group rule direction %q must be one of ingress or egress", errors.ErrValidation, value)
+			}
+		case "protocol":
+			switch protocol := regionv1.SecurityGroupRuleProtocol(value); protocol {
+			case regionv1.Any, regionv1.ICMP, regionv1.TCP, regionv1.UDP, regionv1.VRRP:
+				rule.Protocol = protocol
+			default:
+				return fmt.Errorf("%w: security group rule protocol %q must be one of any, icmp, tcp, udp or vrrp", errors.ErrValidation, value)
+			}
+		case "port":
+			port, err := parsePorts(value)
+			if err != nil {
+				return err
+			}
+
+			rule.Port = port
+		case "cidr":
+			ip, prefix, err := net.ParseCIDR(value)
+			if err != nil || ip.To4() == nil {
+				return fmt.Errorf("%w: security group rule CIDR %q is not a valid IPv4 prefix", errors.ErrValidation, value)
+			}
+
+			rule.CIDR = &unikornv1core.IPv4Prefix{IPNet: *prefix}
+		default:
+			return fmt.Errorf("%w: unknown security group rule field %q, expected one of direction, protocol, port or cidr", errors.ErrValidation, key)
+		}
+	}
+
+	if rule.Protocol == "" {
+		return fmt.Errorf("%w: security group rule protocol must be specified", errors.ErrValidation)
+	}
+
+	// Ports only have meaning for layer 4 protocols.
+	if rule.Port != nil && rule.Protocol != regionv1.TCP && rule.Protocol != regionv1.UDP {
+		return fmt.Errorf("%w: security group rule ports may only be specified for tcp or udp", errors.ErrValidation)
+	}
+
+	*f = append(*f, rule)
+
+	return nil
+}
@@ -22,7 +22,7 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/nscaledev/unicli/pkg/factory"
-	"github.com/nscaledev/unicli/pkg/util"
+	"github.com/nscaledev/unicli/pkg/resolve"
 	kubernetesv1 "github.com/unikorn-cloud/kubernetes/pkg/apis/unikorn/v1alpha1"
 
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -67,19 +67,7 @@ func (f *VirtualKubernetesClusterFlags) Validate(ctx context.Context, cli client
 		return nil
 	}
 
-	var organizationID string
-
-	if f.organizationFlags.Organization != nil {
-		organizationID = f.organizationFlags.Organization.Name
-	}
-
-	var projectID string
-
-	if f.projectFlags.Project != nil {
-		projectID = f.projectFlags.Project.Name
-	}
-
-	cluster, err := util.GetVirtualKubernetesCluster(ctx, cli, organizationID, projectID, f.ClusterName)
+	cluster, err := resolve.VirtualKubernetesCluster(ctx, cli, Scope(f.unikornFlags, f.organizationFlags, f.projectFlags), f.ClusterName)
 	if err != nil {
 		return err
 	}
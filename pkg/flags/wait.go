@@ -0,0 +1,131 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package flags
+
+import (
+	"context"
+	goerrors "errors"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/nscaledev/unicli/pkg/errors"
+	unikornv1core "github.com/unikorn-cloud/core/pkg/apis/unikorn/v1alpha1"
+	"github.com/unikorn-cloud/core/pkg/util/retry"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// ConditionResource is any resource that reports unikorn status conditions.
+type ConditionResource interface {
+	client.Object
+	unikornv1core.StatusConditionReader
+}
+
+// WaitFlags are common to commands that create or modify resources, and handle
+// waiting for the resource to be provisioned.
+type WaitFlags struct {
+	Wait    bool
+	Timeout time.Duration
+}
+
+func NewWaitFlags(timeout time.Duration) *WaitFlags {
+	return &WaitFlags{
+		Wait:    true,
+		Timeout: timeout,
+	}
+}
+
+func (f *WaitFlags) AddFlags(cmd *cobra.Command) {
+	cmd.Flags().BoolVar(&f.Wait, "wait", f.Wait, "Wait for the resource to be provisioned.")
+	cmd.Flags().DurationVar(&f.Timeout, "timeout", f.Timeout, "How long to wait for the resource to be provisioned when --wait is specified.")
+}
+
+// WaitFor polls the resource until the check passes, returning the last error
+// encountered if the timeout expires.  A check that reports the resource has
+// failed stops waiting immediately.
+func (f *WaitFlags) WaitFor(ctx context.Context, cli client.Client, resource client.Object, check func() error) error {
+	if !f.Wait {
+		return nil
+	}
+
+	// Individual API calls are bounded by the request timeout, provisioning
+	// as a whole by our own.
+	waitCtx, cancel := context.WithTimeout(ctx, f.Timeout)
+	defer cancel()
+
+	var failed error
+
+	callback := func() error {
+		if err := cli.Get(waitCtx, client.ObjectKeyFromObject(resource), resource); err != nil {
+			return err
+		}
+
+		if err := check(); err != nil {
+			if goerrors.Is(err, errors.ErrFailed) {
+				failed = err
+
+				return nil
+			}
+
+			return err
+		}
+
+		return nil
+	}
+
+	if err := retry.Forever().DoWithContext(waitCtx, callback); err != nil {
+		return err
+	}
+
+	return failed
+}
+
+// WaitForProvisioned polls the resource until its available condition reports
+// it has been provisioned.
+func (f *WaitFlags) WaitForProvisioned(ctx context.Context, cli client.Client, kind, name string, resource ConditionResource) error {
+	if !f.Wait {
+		return nil
+	}
+
+	check := func() error {
+		condition, err := resource.StatusConditionRead(unikornv1core.ConditionAvailable)
+		if err != nil {
+			return fmt.Errorf("%w: %s %s has not reported its status", errors.ErrResource, kind, name)
+		}
+
+		// Errored resources won't recover without intervention.
+		if condition.Reason == unikornv1core.ConditionReasonErrored {
+			return fmt.Errorf("%w: %s %s is %s: %s", errors.ErrFailed, kind, name, condition.Reason, condition.Message)
+		}
+
+		if condition.Reason != unikornv1core.ConditionReasonProvisioned {
+			return fmt.Errorf("%w: %s %s is %s: %s", errors.ErrResource, kind, name, condition.Reason, condition.Message)
+		}
+
+		return nil
+	}
+
+	if err := f.WaitFor(ctx, cli, resource, check); err != nil {
+		return err
+	}
+
+	fmt.Printf("%s %s provisioned\n", kind, name)
+
+	return nil
+}
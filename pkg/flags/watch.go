@@ -0,0 +1,280 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package flags
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/spf13/cobra"
+
+	"github.com/nscaledev/unicli/pkg/factory"
+	"github.com/nscaledev/unicli/pkg/printer"
+	unikornv1core "github.com/unikorn-cloud/core/pkg/apis/unikorn/v1alpha1"
+	"github.com/unikorn-cloud/core/pkg/constants"
+
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	toolscache "k8s.io/client-go/tools/cache"
+
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+)
+
+const (
+	// watchDebounce batches up changes, especially the initial flood of
+	// resources, so tables aren't redrawn excessively.
+	watchDebounce = 250 * time.Millisecond
+
+	// watchTransitions is how many recent status changes are shown.
+	watchTransitions = 10
+)
+
+// WatchFlags are common to list commands, and allow changes to resources to be
+// followed until interrupted.
+type WatchFlags struct {
+	Watch bool
+
+	printFlags *printer.Flags
+}
+
+func NewWatchFlags(printFlags *printer.Flags) *WatchFlags {
+	return &WatchFlags{
+		printFlags: printFlags,
+	}
+}
+
+func (f *WatchFlags) AddFlags(cmd *cobra.Command) {
+	cmd.Flags().BoolVarP(&f.Watch, "watch", "w", false, "After listing the resources, watch for changes until interrupted.")
+}
+
+// cachedClient reads the watched kind of resource from the cache, so renders
+// reflect the events that triggered them, anything else e.g. organizations and
+// projects used for names, is read directly.
+type cachedClient struct {
+	client.Client
+
+	cache cache.Cache
+	gvk   schema.GroupVersionKind
+}
+
+func (c *cachedClient) cached(obj runtime.Object, suffix string) bool {
+	gvk, err := apiutil.GVKForObject(obj, c.Scheme())
+	if err != nil {
+		return false
+	}
+
+	return gvk.GroupVersion() == c.gvk.GroupVersion() && gvk.Kind == c.gvk.Kind+suffix
+}
+
+func (c *cachedClient) Get(ctx context.Context, key client.ObjectKey, obj client.Object, opts ...client.GetOption) error {
+	if c.cached(obj, "") {
+		return c.cache.Get(ctx, key, obj, opts...)
+	}
+
+	return c.Client.Get(ctx, key, obj, opts...)
+}
+
+func (c *cachedClient) List(ctx context.Context, list client.ObjectList, opts ...client.ListOption) error {
+	if c.cached(list, "List") {
+		return c.cache.List(ctx, list, opts...)
+	}
+
+	return c.Client.List(ctx, list, opts...)
+}
+
+type watchEvent struct {
+	eventType string
+	resource  ConditionResource
+}
+
+// Run watches resources of the same kind as the one provided that match the
+// selector.  Tables are redrawn by the render callback on each change, along
+// with any recent status transitions, structured output streams an event per
+// change.  The render callback is given a client that reads watched resources
+// from the watch's cache.
+func (f *WatchFlags) Run(ctx context.Context, factory *factory.Factory, cli client.Client, resource ConditionResource, selector labels.Selector, render func(context.Context, client.Client) error) error {
+	// Stop the cache when we're done watching.
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	cache, err := factory.Cache(ctx, resource, "", selector)
+	if err != nil {
+		return err
+	}
+
+	gvk, err := apiutil.GVKForObject(resource, cli.Scheme())
+	if err != nil {
+		return err
+	}
+
+	kind := strings.ToLower(gvk.Kind)
+
+	reader := &cachedClient{
+		Client: cli,
+		cache:  cache,
+		gvk:    gvk,
+	}
+
+	informer, err := cache.GetInformer(ctx, resource)
+	if err != nil {
+		return err
+	}
+
+	events := make(chan watchEvent)
+
+	send := func(eventType string, obj any) {
+		if deleted, ok := obj.(toolscache.DeletedFinalStateUnknown); ok {
+			obj = deleted.Obj
+		}
+
+		resource, ok := obj.(ConditionResource)
+		if !ok || !selector.Matches(labels.Set(resource.GetLabels())) {
+			return
+		}
+
+		select {
+		case events <- watchEvent{eventType: eventType, resource: resource}:
+		case <-ctx.Done():
+		}
+	}
+
+	handler := toolscache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj any) {
+			send("ADDED", obj)
+		},
+		UpdateFunc: func(_, obj any) {
+			send("MODIFIED", obj)
+		},
+		DeleteFunc: func(obj any) {
+			send("DELETED", obj)
+		},
+	}
+
+	registration, err := informer.AddEventHandler(handler)
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		_ = informer.RemoveEventHandler(registration)
+	}()
+
+	structured := f.printFlags.Structured()
+
+	reasons := map[types.UID]string{}
+
+	var transitions []string
+
+	// Drawing is deferred until things settle down.
+	timer := time.NewTimer(watchDebounce)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case event := <-events:
+			if structured {
+				object, err := printer.NewObject(cli.Scheme(), event.resource, nil)
+				if err != nil {
+					return err
+				}
+
+				if err := f.printFlags.PrintEvent(os.Stdout, event.eventType, object); err != nil {
+					return err
+				}
+
+				continue
+			}
+
+			if transition := transition(reasons, kind, event); transition != "" {
+				transitions = append(transitions, transition)
+
+				if len(transitions) > watchTransitions {
+					transitions = transitions[1:]
+				}
+			}
+
+			timer.Reset(watchDebounce)
+		case <-timer.C:
+			if structured {
+				continue
+			}
+
+			// Clear the screen and redraw.
+			fmt.Print("\033[H\033[2J")
+
+			if err := render(ctx, reader); err != nil {
+				return err
+			}
+
+			for _, transition := range transitions {
+				fmt.Println(transition)
+			}
+		}
+	}
+}
+
+// transition records the resource's current status, and describes any change
+// from its previously recorded one.
+func transition(reasons map[types.UID]string, kind string, event watchEvent) string {
+	name := event.resource.GetLabels()[constants.NameLabel]
+	timestamp := time.Now().Format(time.TimeOnly)
+
+	previous, seen := reasons[event.resource.GetUID()]
+
+	if event.eventType == "DELETED" {
+		delete(reasons, event.resource.GetUID())
+
+		return fmt.Sprintf("%s %s %s: %s", timestamp, kind, name, statusStyle(unikornv1core.ConditionReasonErrored).Render("deleted"))
+	}
+
+	var reason string
+
+	if condition, err := event.resource.StatusConditionRead(unikornv1core.ConditionAvailable); err == nil {
+		reason = string(condition.Reason)
+	}
+
+	reasons[event.resource.GetUID()] = reason
+
+	if !seen || previous == reason {
+		return ""
+	}
+
+	return fmt.Sprintf("%s %s %s: %s → %s", timestamp, kind, name, previous, statusStyle(unikornv1core.ConditionReason(reason)).Render(reason))
+}
+
+// statusStyle highlights status transitions by how good or bad they are.
+func statusStyle(reason unikornv1core.ConditionReason) lipgloss.Style {
+	style := lipgloss.NewStyle().Bold(true)
+
+	switch reason {
+	case unikornv1core.ConditionReasonProvisioned:
+		return style.Foreground(lipgloss.Color("#16A34A"))
+	case unikornv1core.ConditionReasonErrored:
+		return style.Foreground(lipgloss.Color("#DC2626"))
+	}
+
+	return style.Foreground(lipgloss.Color("#CA8A04"))
+}
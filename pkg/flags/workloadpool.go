@@ -0,0 +1,107 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package flags
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/nscaledev/unicli/pkg/errors"
+)
+
+// WorkloadPool is a workload pool as described on the command line, flavors are
+// as the user specified them and need resolving against a region, images are
+// always IDs.
+type WorkloadPool struct {
+	Name     string
+	Flavor   string
+	Image    string
+	Replicas int
+}
+
+// WorkloadPoolsFlag is a repeatable flag that accumulates workload pools in the
+// form name=default,flavor=<flavor-id>,image=<image-id>,replicas=3, flavors
+// may also be given by name.
+type WorkloadPoolsFlag []WorkloadPool
+
+func (f *WorkloadPoolsFlag) String() string {
+	if len(*f) == 0 {
+		return ""
+	}
+
+	pools := make([]string, len(*f))
+
+	for i, pool := range *f {
+		pools[i] = fmt.Sprintf("name=%s,flavor=%s,image=%s,replicas=%d", pool.Name, pool.Flavor, pool.Image, pool.Replicas)
+	}
+
+	return "[" + strings.Join(pools, " ") + "]"
+}
+
+func (f *WorkloadPoolsFlag) Type() string {
+	return "workloadPool"
+}
+
+func (f *WorkloadPoolsFlag) Set(s string) error {
+	pool := WorkloadPool{
+		Replicas: 1,
+	}
+
+	for field := range strings.SplitSeq(s, ",") {
+		key, value, ok := strings.Cut(field, "=")
+		if !ok {
+			return fmt.Errorf("%w: workload pool field %q must be in the form key=value", errors.ErrValidation, field)
+		}
+
+		switch key {
+		case "name":
+			pool.Name = value
+		case "flavor":
+			pool.Flavor = value
+		case "image":
+			pool.Image = value
+		case "replicas":
+			replicas, err := strconv.Atoi(value)
+			if err != nil || replicas < 0 {
+				return fmt.Errorf("%w: workload pool replicas %q must be a non-negative integer", errors.ErrValidation, value)
+			}
+
+			pool.Replicas = replicas
+		default:
+			return fmt.Errorf("%w: unknown workload pool field %q, expected one of name, flavor, image or replicas", errors.ErrValidation, key)
+		}
+	}
+
+	if pool.Name == "" {
+		return fmt.Errorf("%w: workload pool name must be specified", errors.ErrValidation)
+	}
+
+	if pool.Flavor == "" {
+		return fmt.Errorf("%w: workload pool %s flavor must be specified", errors.ErrValidation, pool.Name)
+	}
+
+	for i := range *f {
+		if (*f)[i].Name == pool.Name {
+			return fmt.Errorf("%w: workload pool %s specified more than once", errors.ErrValidation, pool.Name)
+		}
+	}
+
+	*f = append(*f, pool)
+
+	return nil
+}
@@ -19,19 +19,19 @@ package clustermanager
 import (
 	"context"
 	"fmt"
+	"os"
 	"strings"
-	"time"
 
 	"github.com/charmbracelet/lipgloss"
-	"github.com/charmbracelet/lipgloss/table"
 	"github.com/spf13/cobra"
 
 	"github.com/nscaledev/unicli/pkg/factory"
 	"github.com/nscaledev/unicli/pkg/flags"
+	"github.com/nscaledev/unicli/pkg/printer"
 	"github.com/unikorn-cloud/core/pkg/constants"
 	kubernetesv1 "github.com/unikorn-cloud/kubernetes/pkg/apis/unikorn/v1alpha1"
 
-	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/labels"
 
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -41,8 +41,11 @@ import (
 
 type options struct {
 	UnikornFlags *factory.UnikornFlags
+	PrintFlags   *printer.Flags
 
 	organization *flags.OrganizationFlags
+	watch        *flags.WatchFlags
+	contexts     *flags.ContextsFlags
 }
 
 func (o *options) AddFlags(cmd *cobra.Command, factory *factory.Factory) error {
@@ -50,9 +53,26 @@ func (o *options) AddFlags(cmd *cobra.Command, factory *factory.Factory) error {
 		return err
 	}
 
+	o.watch.AddFlags(cmd)
+
+	if err := o.contexts.AddFlags(cmd, factory); err != nil {
+		return err
+	}
+
 	return nil
 }
 
+// clone returns a copy of the options that can be validated against another
+// management cluster, flags are resolved to resources specific to each cluster.
+func (o *options) clone() *options {
+	organization := o.organization.Clone()
+
+	clone := *o
+	clone.organization = organization
+
+	return &clone
+}
+
 func (o *options) validate(ctx context.Context, cli client.Client) error {
 	validators := []func(context.Context, client.Client) error{
 		o.organization.Validate,
@@ -73,7 +93,10 @@ func Command(factory *factory.Factory) *cobra.Command {
 
 	o := options{
 		UnikornFlags: unikornFlags,
+		PrintFlags:   &factory.PrintFlags,
 		organization: organizationFlags,
+		watch:        flags.NewWatchFlags(&factory.PrintFlags),
+		contexts:     &flags.ContextsFlags{},
 	}
 
 	cmd := &cobra.Command{
@@ -84,10 +107,25 @@ func Command(factory *factory.Factory) *cobra.Command {
 			"cm",
 		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
-			defer cancel()
+			ctx := cmd.Context()
+
+			if err := o.contexts.Validate(cmd, factory, o.watch); err != nil {
+				return err
+			}
 
-			client, err := factory.Client()
+			if o.contexts.Enabled() {
+				return o.contexts.Run(ctx, factory, func(ctx context.Context, cli client.Client) (*printer.Listing, error) {
+					clone := o.clone()
+
+					if err := clone.validate(ctx, cli); err != nil {
+						return nil, err
+					}
+
+					return clone.list(ctx, cli)
+				})
+			}
+
+			client, err := factory.Client(ctx)
 			if err != nil {
 				return err
 			}
@@ -96,6 +134,10 @@ func Command(factory *factory.Factory) *cobra.Command {
 				return err
 			}
 
+			if o.watch.Watch {
+				return o.watch.Run(ctx, factory, client, &kubernetesv1.ClusterManager{}, o.selector(), o.execute)
+			}
+
 			if err := o.execute(ctx, client); err != nil {
 				return err
 			}
@@ -111,45 +153,69 @@ func Command(factory *factory.Factory) *cobra.Command {
 	return cmd
 }
 
-func (o *options) execute(ctx context.Context, cli client.Client) error {
+// selector returns the labels listed resources must match.
+func (o *options) selector() labels.Selector {
 	l := labels.Set{}
 
 	if o.organization.Organization != nil {
 		l[constants.OrganizationLabel] = o.organization.Organization.Name
 	}
 
-	namespaces := &corev1.NamespaceList{}
-	if err := cli.List(ctx, namespaces); err != nil {
-		return fmt.Errorf("failed to list namespaces: %w", err)
-	}
+	return labels.SelectorFromSet(l)
+}
 
-	// Collect all cluster managers across namespaces
-	var allManagers []kubernetesv1.ClusterManager
+func (o *options) execute(ctx context.Context, cli client.Client) error {
+	listing, err := o.list(ctx, cli)
+	if err != nil {
+		return err
+	}
 
-	for _, namespace := range namespaces.Items {
-		options := &client.ListOptions{
-			LabelSelector: labels.SelectorFromSet(l),
-			Namespace:     namespace.Name,
-		}
+	return o.PrintFlags.PrintListing(os.Stdout, listing)
+}
 
-		resources := &kubernetesv1.ClusterManagerList{}
-		if err := cli.List(ctx, resources, options); err != nil {
-			return fmt.Errorf("failed to list cluster managers in namespace %s: %w", namespace.Name, err)
-		}
+func (o *options) list(ctx context.Context, cli client.Client) (*printer.Listing, error) {
+	options := &client.ListOptions{
+		LabelSelector: o.selector(),
+	}
 
-		allManagers = append(allManagers, resources.Items...)
+	resources := &kubernetesv1.ClusterManagerList{}
+	if err := util.ListAllNamespaces(ctx, cli, o.UnikornFlags.IdentityNamespace, o.UnikornFlags.ServiceNamespaces(), resources, options); err != nil {
+		return nil, fmt.Errorf("failed to list cluster managers: %w", err)
 	}
 
+	allManagers := resources.Items
+
 	// Create maps for ID to name lookups
 	orgNames, err := util.CreateOrganizationNameMap(ctx, cli, o.UnikornFlags.IdentityNamespace)
 	if err != nil {
-		return fmt.Errorf("failed to list organizations: %w", err)
+		return nil, fmt.Errorf("failed to list organizations: %w", err)
+	}
+
+	if o.PrintFlags.Structured() {
+		objects := make([]unstructured.Unstructured, len(allManagers))
+
+		for i := range allManagers {
+			resource := &allManagers[i]
+
+			references := map[string]printer.Reference{
+				"organization": printer.NewReference(orgNames, resource.Labels[constants.OrganizationLabel]),
+			}
+
+			object, err := printer.NewObject(cli.Scheme(), resource, references)
+			if err != nil {
+				return nil, err
+			}
+
+			objects[i] = *object
+		}
+
+		return &printer.Listing{Objects: objects}, nil
 	}
 
 	// Get all KubernetesClusters to count associated clusters
 	allClusters := &kubernetesv1.KubernetesClusterList{}
 	if err := cli.List(ctx, allClusters); err != nil {
-		return fmt.Errorf("failed to list kubernetes clusters: %w", err)
+		return nil, fmt.Errorf("failed to list kubernetes clusters: %w", err)
 	}
 
 	// Create a map of clustermanager IDs to cluster names
@@ -169,20 +235,7 @@ func (o *options) execute(ctx context.Context, cli client.Client) error {
 	}
 
 	// Create table
-	t := table.New().
-		Border(lipgloss.RoundedBorder()).
-		BorderStyle(lipgloss.NewStyle().Foreground(lipgloss.Color("#1E3A8A"))).
-		Headers("Name", "ID", "Organization", "Clusters", "Namespace", "Status").
-		StyleFunc(func(row, col int) lipgloss.Style {
-			if row == table.HeaderRow {
-				return lipgloss.NewStyle().
-					Bold(true).
-					Foreground(lipgloss.Color("#FAFAFA")).
-					Background(lipgloss.Color("#1E3A8A")).
-					Padding(0, 1)
-			}
-			return lipgloss.NewStyle()
-		})
+	t := printer.NewTable("Name", "ID", "Organization", "Clusters", "Namespace", "Status")
 
 	// Add rows
 	for i := range allManagers {
@@ -221,7 +274,5 @@ func (o *options) execute(ctx context.Context, cli client.Client) error {
 		)
 	}
 
-	// Print the table
-	fmt.Println(t)
-	return nil
+	return &printer.Listing{Table: t}, nil
 }
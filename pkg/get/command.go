@@ -22,9 +22,18 @@ import (
 	"github.com/nscaledev/unicli/pkg/factory"
 	"github.com/nscaledev/unicli/pkg/get/clustermanager"
 	"github.com/nscaledev/unicli/pkg/get/computeinstance"
+	"github.com/nscaledev/unicli/pkg/get/flavor"
+	"github.com/nscaledev/unicli/pkg/get/group"
+	"github.com/nscaledev/unicli/pkg/get/image"
+	"github.com/nscaledev/unicli/pkg/get/kubeconfig"
 	"github.com/nscaledev/unicli/pkg/get/kubernetescluster"
 	"github.com/nscaledev/unicli/pkg/get/network"
 	"github.com/nscaledev/unicli/pkg/get/openstackidentity"
+	"github.com/nscaledev/unicli/pkg/get/organization"
+	"github.com/nscaledev/unicli/pkg/get/project"
+	"github.com/nscaledev/unicli/pkg/get/region"
+	"github.com/nscaledev/unicli/pkg/get/role"
+	"github.com/nscaledev/unicli/pkg/get/securitygroup"
 	"github.com/nscaledev/unicli/pkg/get/sshkey"
 	"github.com/nscaledev/unicli/pkg/get/user"
 	"github.com/nscaledev/unicli/pkg/get/virtualkubernetescluster"
@@ -39,9 +48,18 @@ func Command(factory *factory.Factory) *cobra.Command {
 	cmd.AddCommand(
 		clustermanager.Command(factory),
 		computeinstance.Command(factory),
+		flavor.Command(factory),
+		group.Command(factory),
+		image.Command(factory),
+		kubeconfig.Command(factory),
 		kubernetescluster.Command(factory),
 		network.Command(factory),
 		openstackidentity.Command(factory),
+		organization.Command(factory),
+		project.Command(factory),
+		region.Command(factory),
+		role.Command(factory),
+		securitygroup.Command(factory),
 		sshkey.Command(factory),
 		user.Command(factory),
 		virtualkubernetescluster.Command(factory),
@@ -0,0 +1,339 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package flavor
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/nscaledev/unicli/pkg/errors"
+	"github.com/nscaledev/unicli/pkg/factory"
+	"github.com/nscaledev/unicli/pkg/flags"
+	"github.com/nscaledev/unicli/pkg/printer"
+	"github.com/nscaledev/unicli/pkg/util"
+	"github.com/unikorn-cloud/core/pkg/constants"
+	regionv1 "github.com/unikorn-cloud/region/pkg/apis/unikorn/v1alpha1"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// allColumns defines every available column name.
+var allColumns = []string{"id", "name", "architecture", "cpus", "cpu-family", "memory", "disk", "gpu-vendor", "gpu-model", "gpus", "gpu-memory", "baremetal"}
+
+// defaultColumns is the set shown when --columns is not specified.
+var defaultColumns = []string{"id", "name", "cpus", "memory", "gpu-vendor", "gpu-model", "gpus", "baremetal"}
+
+// gpuVendors are the GPU vendors flavors can be filtered by.
+var gpuVendors = []string{string(regionv1.NVIDIA), string(regionv1.AMD)}
+
+type options struct {
+	UnikornFlags *factory.UnikornFlags
+	PrintFlags   *printer.Flags
+
+	region    *flags.RegionFlags
+	gpuVendor string
+	minCPUs   int
+	minMemory string
+	columns   []string
+
+	minMemoryQuantity *resource.Quantity
+}
+
+func (o *options) AddFlags(cmd *cobra.Command, factory *factory.Factory) error {
+	if err := o.region.AddFlags(cmd, factory, true); err != nil {
+		return err
+	}
+
+	cmd.Flags().StringVar(&o.gpuVendor, "gpu-vendor", "", fmt.Sprintf("Only show flavors with GPUs from the vendor, one of: %s.", strings.Join(gpuVendors, ", ")))
+	cmd.Flags().IntVar(&o.minCPUs, "min-cpus", 0, "Only show flavors with at least this many CPUs.")
+	cmd.Flags().StringVar(&o.minMemory, "min-memory", "", "Only show flavors with at least this much memory e.g. 64Gi.")
+	cmd.Flags().StringSliceVar(&o.columns, "columns", defaultColumns,
+		fmt.Sprintf("Comma-separated list of columns to display. Available: %s", strings.Join(allColumns, ", ")))
+
+	if err := cmd.RegisterFlagCompletionFunc("gpu-vendor", cobra.FixedCompletions(gpuVendors, cobra.ShellCompDirectiveNoFileComp)); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (o *options) validateFilters(ctx context.Context, cli client.Client) error {
+	if o.gpuVendor != "" {
+		index := slices.IndexFunc(gpuVendors, func(vendor string) bool {
+			return strings.EqualFold(vendor, o.gpuVendor)
+		})
+
+		if index < 0 {
+			return fmt.Errorf("%w: unknown GPU vendor %q, expected one of: %s", errors.ErrValidation, o.gpuVendor, strings.Join(gpuVendors, ", "))
+		}
+
+		o.gpuVendor = gpuVendors[index]
+	}
+
+	if o.minMemory != "" {
+		quantity, err := resource.ParseQuantity(o.minMemory)
+		if err != nil {
+			return fmt.Errorf("%w: invalid minimum memory %q: %w", errors.ErrValidation, o.minMemory, err)
+		}
+
+		o.minMemoryQuantity = &quantity
+	}
+
+	return nil
+}
+
+func (o *options) validateColumns(ctx context.Context, cli client.Client) error {
+	if o.PrintFlags.Wide() && slices.Equal(o.columns, defaultColumns) {
+		o.columns = allColumns
+	}
+
+	for _, col := range o.columns {
+		if !slices.Contains(allColumns, strings.ToLower(col)) {
+			return fmt.Errorf("unknown column %q, available columns: %s", col, strings.Join(allColumns, ", "))
+		}
+	}
+
+	return nil
+}
+
+func (o *options) validate(ctx context.Context, cli client.Client) error {
+	validators := []func(context.Context, client.Client) error{
+		o.region.Validate,
+		o.validateFilters,
+		o.validateColumns,
+	}
+
+	for _, validator := range validators {
+		if err := validator(ctx, cli); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func Command(factory *factory.Factory) *cobra.Command {
+	o := options{
+		UnikornFlags: &factory.UnikornFlags,
+		PrintFlags:   &factory.PrintFlags,
+		region:       flags.NewRegionFlags(&factory.UnikornFlags),
+	}
+
+	cmd := &cobra.Command{
+		Use:   "flavor",
+		Short: "Get flavors advertised by a region",
+		Long: `Get flavors advertised by a region.
+
+Flavors are described by the region's configuration, Openstack regions that
+don't select flavors explicitly export every flavor the cloud has, and only
+those with additional metadata are shown.
+
+Examples:
+  # List NVIDIA GPU flavors with at least 256Gi of memory
+  unicli get flavor --region uk-south --gpu-vendor nvidia --min-memory 256Gi`,
+		Aliases: []string{
+			"flavors",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			client, err := factory.Client(ctx)
+			if err != nil {
+				return err
+			}
+
+			if err := o.validate(ctx, client); err != nil {
+				return err
+			}
+
+			if err := o.execute(); err != nil {
+				return err
+			}
+
+			return nil
+		},
+	}
+
+	if err := o.AddFlags(cmd, factory); err != nil {
+		panic(err)
+	}
+
+	return cmd
+}
+
+// matches returns true if the flavor satisfies all the filters, flavors that
+// don't describe a filtered attribute are excluded.
+func (o *options) matches(flavor *util.Flavor) bool {
+	if o.gpuVendor != "" && (flavor.GPU == nil || string(flavor.GPU.Vendor) != o.gpuVendor) {
+		return false
+	}
+
+	if o.minCPUs > 0 && (flavor.CPUs == nil || *flavor.CPUs < o.minCPUs) {
+		return false
+	}
+
+	if o.minMemoryQuantity != nil && (flavor.Memory == nil || flavor.Memory.Cmp(*o.minMemoryQuantity) < 0) {
+		return false
+	}
+
+	return true
+}
+
+func (o *options) object(flavor *util.Flavor) (*unstructured.Unstructured, error) {
+	content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(flavor)
+	if err != nil {
+		return nil, err
+	}
+
+	content["kind"] = "Flavor"
+	content["metadata"] = map[string]any{
+		"name": flavor.ID,
+	}
+	content["region"] = map[string]any{
+		"id":   o.region.Region.Name,
+		"name": o.region.Region.Labels[constants.NameLabel],
+	}
+
+	return &unstructured.Unstructured{Object: content}, nil
+}
+
+func (o *options) execute() error {
+	if !o.PrintFlags.Structured() && util.ExportsAllFlavors(o.region.Region) {
+		fmt.Fprintf(os.Stderr, "region %s exports all flavors, only those with metadata are shown\n", o.region.Region.Labels[constants.NameLabel])
+	}
+
+	listing, err := o.list()
+	if err != nil {
+		return err
+	}
+
+	return o.PrintFlags.PrintListing(os.Stdout, listing)
+}
+
+func (o *options) list() (*printer.Listing, error) {
+	var flavors []util.Flavor
+
+	for _, flavor := range util.RegionFlavors(o.region.Region) {
+		if o.matches(&flavor) {
+			flavors = append(flavors, flavor)
+		}
+	}
+
+	if o.PrintFlags.Structured() {
+		objects := make([]unstructured.Unstructured, len(flavors))
+
+		for i := range flavors {
+			object, err := o.object(&flavors[i])
+			if err != nil {
+				return nil, err
+			}
+
+			objects[i] = *object
+		}
+
+		return &printer.Listing{Objects: objects}, nil
+	}
+
+	// Build headers from selected columns
+	headerMap := map[string]string{
+		"id":           "ID",
+		"name":         "Name",
+		"architecture": "Architecture",
+		"cpus":         "CPUs",
+		"cpu-family":   "CPU Family",
+		"memory":       "Memory",
+		"disk":         "Disk",
+		"gpu-vendor":   "GPU Vendor",
+		"gpu-model":    "GPU Model",
+		"gpus":         "GPUs",
+		"gpu-memory":   "GPU Memory",
+		"baremetal":    "Baremetal",
+	}
+
+	headers := make([]string, 0, len(o.columns))
+	for _, col := range o.columns {
+		headers = append(headers, headerMap[col])
+	}
+
+	t := printer.NewTable(headers...)
+
+	for i := range flavors {
+		flavor := &flavors[i]
+
+		cpus := ""
+		if flavor.CPUs != nil {
+			cpus = strconv.Itoa(*flavor.CPUs)
+		}
+
+		memory := ""
+		if flavor.Memory != nil {
+			memory = flavor.Memory.String()
+		}
+
+		disk := ""
+		if flavor.Disk != nil {
+			disk = flavor.Disk.String()
+		}
+
+		var gpuVendor, gpuModel, gpus, gpuMemory string
+
+		if flavor.GPU != nil {
+			gpuVendor = string(flavor.GPU.Vendor)
+			gpuModel = flavor.GPU.Model
+			gpus = strconv.Itoa(flavor.GPU.PhysicalCount)
+
+			if flavor.GPU.Memory != nil {
+				gpuMemory = flavor.GPU.Memory.String()
+			}
+		}
+
+		// Build row values in column order
+		valueMap := map[string]string{
+			"id":           flavor.ID,
+			"name":         flavor.Name,
+			"architecture": flavor.Architecture,
+			"cpus":         cpus,
+			"cpu-family":   flavor.CPUFamily,
+			"memory":       memory,
+			"disk":         disk,
+			"gpu-vendor":   gpuVendor,
+			"gpu-model":    gpuModel,
+			"gpus":         gpus,
+			"gpu-memory":   gpuMemory,
+			"baremetal":    strconv.FormatBool(flavor.Baremetal),
+		}
+
+		var row []string
+		for _, col := range o.columns {
+			row = append(row, valueMap[col])
+		}
+
+		t.Row(row...)
+	}
+
+	return &printer.Listing{Table: t}, nil
+}
@@ -0,0 +1,278 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package group
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/nscaledev/unicli/pkg/factory"
+	"github.com/nscaledev/unicli/pkg/flags"
+	"github.com/nscaledev/unicli/pkg/printer"
+	"github.com/nscaledev/unicli/pkg/util"
+	"github.com/unikorn-cloud/core/pkg/constants"
+	identityv1 "github.com/unikorn-cloud/identity/pkg/apis/unikorn/v1alpha1"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// allColumns defines every available column name.
+var allColumns = []string{"name", "id", "organization", "roles", "users"}
+
+// defaultColumns is the set shown when --columns is not specified.
+var defaultColumns = []string{"name", "organization", "roles", "users"}
+
+type options struct {
+	UnikornFlags *factory.UnikornFlags
+	PrintFlags   *printer.Flags
+
+	organization *flags.OrganizationFlags
+	columns      []string
+	contexts     *flags.ContextsFlags
+}
+
+func (o *options) AddFlags(cmd *cobra.Command, factory *factory.Factory) error {
+	if err := o.organization.AddFlags(cmd, factory, false); err != nil {
+		return err
+	}
+
+	cmd.Flags().StringSliceVar(&o.columns, "columns", defaultColumns,
+		fmt.Sprintf("Comma-separated list of columns to display. Available: %s", strings.Join(allColumns, ", ")))
+
+	if err := o.contexts.AddFlags(cmd, factory); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// clone returns a copy of the options that can be validated against another
+// management cluster, flags are resolved to resources specific to each cluster.
+func (o *options) clone() *options {
+	clone := *o
+	clone.organization = o.organization.Clone()
+
+	return &clone
+}
+
+func (o *options) validate(ctx context.Context, cli client.Client) error {
+	validators := []func(context.Context, client.Client) error{
+		o.organization.Validate,
+	}
+
+	for _, validator := range validators {
+		if err := validator(ctx, cli); err != nil {
+			return err
+		}
+	}
+
+	if o.PrintFlags.Wide() && slices.Equal(o.columns, defaultColumns) {
+		o.columns = allColumns
+	}
+
+	for _, col := range o.columns {
+		if !slices.Contains(allColumns, strings.ToLower(col)) {
+			return fmt.Errorf("unknown column %q, available columns: %s", col, strings.Join(allColumns, ", "))
+		}
+	}
+
+	return nil
+}
+
+func Command(factory *factory.Factory) *cobra.Command {
+	unikornFlags := &factory.UnikornFlags
+
+	o := options{
+		UnikornFlags: unikornFlags,
+		PrintFlags:   &factory.PrintFlags,
+		organization: flags.NewOrganizationFlags(unikornFlags),
+		contexts:     &flags.ContextsFlags{},
+	}
+
+	cmd := &cobra.Command{
+		Use:   "group",
+		Short: "Get groups",
+		Aliases: []string{
+			"groups",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			if err := o.contexts.Validate(cmd, factory, nil); err != nil {
+				return err
+			}
+
+			if o.contexts.Enabled() {
+				return o.contexts.Run(ctx, factory, func(ctx context.Context, cli client.Client) (*printer.Listing, error) {
+					clone := o.clone()
+
+					if err := clone.validate(ctx, cli); err != nil {
+						return nil, err
+					}
+
+					return clone.list(ctx, cli)
+				})
+			}
+
+			client, err := factory.Client(ctx)
+			if err != nil {
+				return err
+			}
+
+			if err := o.validate(ctx, client); err != nil {
+				return err
+			}
+
+			if err := o.execute(ctx, client); err != nil {
+				return err
+			}
+
+			return nil
+		},
+	}
+
+	if err := o.AddFlags(cmd, factory); err != nil {
+		panic(err)
+	}
+
+	return cmd
+}
+
+// selector returns the labels listed resources must match.
+func (o *options) selector() labels.Selector {
+	l := labels.Set{}
+
+	if o.organization.Organization != nil {
+		l[constants.OrganizationLabel] = o.organization.Organization.Name
+	}
+
+	return labels.SelectorFromSet(l)
+}
+
+func (o *options) execute(ctx context.Context, cli client.Client) error {
+	listing, err := o.list(ctx, cli)
+	if err != nil {
+		return err
+	}
+
+	return o.PrintFlags.PrintListing(os.Stdout, listing)
+}
+
+// groupRoles returns the sorted display names of a group's roles.
+func groupRoles(group *identityv1.Group, names map[string]string) []string {
+	result := make([]string, len(group.Spec.RoleIDs))
+
+	for i, id := range group.Spec.RoleIDs {
+		result[i] = printer.NewReference(names, id).Name
+	}
+
+	slices.Sort(result)
+
+	return result
+}
+
+func (o *options) list(ctx context.Context, cli client.Client) (*printer.Listing, error) {
+	options := &client.ListOptions{
+		LabelSelector: o.selector(),
+	}
+
+	resources := &identityv1.GroupList{}
+	if err := util.ListAllNamespaces(ctx, cli, o.UnikornFlags.IdentityNamespace, o.UnikornFlags.ServiceNamespaces(), resources, options); err != nil {
+		return nil, fmt.Errorf("failed to list groups: %w", err)
+	}
+
+	allGroups := resources.Items
+
+	// Create maps for ID to name lookups
+	orgNames, err := util.CreateOrganizationNameMap(ctx, cli, o.UnikornFlags.IdentityNamespace)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list organizations: %w", err)
+	}
+
+	roleNames, err := util.CreateRoleNameMap(ctx, cli, o.UnikornFlags.IdentityNamespace)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list roles: %w", err)
+	}
+
+	if o.PrintFlags.Structured() {
+		objects := make([]unstructured.Unstructured, len(allGroups))
+
+		for i := range allGroups {
+			resource := &allGroups[i]
+
+			references := map[string]printer.Reference{
+				"organization": printer.NewReference(orgNames, resource.Labels[constants.OrganizationLabel]),
+			}
+
+			object, err := printer.NewObject(cli.Scheme(), resource, references)
+			if err != nil {
+				return nil, err
+			}
+
+			objects[i] = *object
+		}
+
+		return &printer.Listing{Objects: objects}, nil
+	}
+
+	// Build headers from selected columns
+	headerMap := map[string]string{
+		"name":         "Name",
+		"id":           "ID",
+		"organization": "Organization",
+		"roles":        "Roles",
+		"users":        "Users",
+	}
+
+	headers := make([]string, 0, len(o.columns))
+	for _, col := range o.columns {
+		headers = append(headers, headerMap[col])
+	}
+
+	t := printer.NewTable(headers...)
+
+	for i := range allGroups {
+		resource := &allGroups[i]
+
+		// Build row values in column order
+		valueMap := map[string]string{
+			"name":         resource.Labels[constants.NameLabel],
+			"id":           resource.Name,
+			"organization": printer.NewReference(orgNames, resource.Labels[constants.OrganizationLabel]).Name,
+			"roles":        strings.Join(groupRoles(resource, roleNames), ", "),
+			"users":        strconv.Itoa(len(resource.Spec.UserIDs)),
+		}
+
+		var row []string
+		for _, col := range o.columns {
+			row = append(row, valueMap[col])
+		}
+
+		t.Row(row...)
+	}
+
+	return &printer.Listing{Table: t}, nil
+}
@@ -0,0 +1,338 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package image
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/nscaledev/unicli/pkg/factory"
+	"github.com/nscaledev/unicli/pkg/flags"
+	"github.com/nscaledev/unicli/pkg/printer"
+	"github.com/nscaledev/unicli/pkg/util"
+	computev1 "github.com/unikorn-cloud/compute/pkg/apis/unikorn/v1alpha1"
+	"github.com/unikorn-cloud/core/pkg/constants"
+	kubernetesv1 "github.com/unikorn-cloud/kubernetes/pkg/apis/unikorn/v1alpha1"
+	regionconstants "github.com/unikorn-cloud/region/pkg/constants"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// allColumns defines every available column name.
+var allColumns = []string{"id", "versions", "clusters", "instances"}
+
+// defaultColumns is the set shown when --columns is not specified.
+var defaultColumns = []string{"id", "versions", "clusters", "instances"}
+
+type options struct {
+	UnikornFlags *factory.UnikornFlags
+	PrintFlags   *printer.Flags
+
+	region  *flags.RegionFlags
+	columns []string
+}
+
+func (o *options) AddFlags(cmd *cobra.Command, factory *factory.Factory) error {
+	if err := o.region.AddFlags(cmd, factory, true); err != nil {
+		return err
+	}
+
+	cmd.Flags().StringSliceVar(&o.columns, "columns", defaultColumns,
+		fmt.Sprintf("Comma-separated list of columns to display. Available: %s", strings.Join(allColumns, ", ")))
+
+	return nil
+}
+
+func (o *options) validateColumns(ctx context.Context, cli client.Client) error {
+	if o.PrintFlags.Wide() && slices.Equal(o.columns, defaultColumns) {
+		o.columns = allColumns
+	}
+
+	for _, col := range o.columns {
+		if !slices.Contains(allColumns, strings.ToLower(col)) {
+			return fmt.Errorf("unknown column %q, available columns: %s", col, strings.Join(allColumns, ", "))
+		}
+	}
+
+	return nil
+}
+
+func (o *options) validate(ctx context.Context, cli client.Client) error {
+	validators := []func(context.Context, client.Client) error{
+		o.region.Validate,
+		o.validateColumns,
+	}
+
+	for _, validator := range validators {
+		if err := validator(ctx, cli); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func Command(factory *factory.Factory) *cobra.Command {
+	o := options{
+		UnikornFlags: &factory.UnikornFlags,
+		PrintFlags:   &factory.PrintFlags,
+		region:       flags.NewRegionFlags(&factory.UnikornFlags),
+	}
+
+	cmd := &cobra.Command{
+		Use:   "image",
+		Short: "Get images in use in a region",
+		Long: `Get images in use in a region.
+
+The image catalogue is held by the cloud provider, not the management cluster,
+so this lists the images that Kubernetes clusters and compute instances in the
+region have been provisioned with, along with the Kubernetes versions clusters
+run on them.  These are known to be valid when creating new resources.
+
+Examples:
+  # List images in use in a region
+  unicli get image --region uk-south`,
+		Aliases: []string{
+			"images",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			client, err := factory.Client(ctx)
+			if err != nil {
+				return err
+			}
+
+			if err := o.validate(ctx, client); err != nil {
+				return err
+			}
+
+			if err := o.execute(ctx, client); err != nil {
+				return err
+			}
+
+			return nil
+		},
+	}
+
+	if err := o.AddFlags(cmd, factory); err != nil {
+		panic(err)
+	}
+
+	return cmd
+}
+
+// image is an image and the resources using it.
+type image struct {
+	id        string
+	versions  []string
+	clusters  []string
+	instances []string
+}
+
+func (i *image) object(region *printer.Reference) unstructured.Unstructured {
+	toAny := func(in []string) []any {
+		out := make([]any, len(in))
+
+		for i := range in {
+			out[i] = in[i]
+		}
+
+		return out
+	}
+
+	return unstructured.Unstructured{
+		Object: map[string]any{
+			"kind": "Image",
+			"metadata": map[string]any{
+				"name": i.id,
+			},
+			"region": map[string]any{
+				"id":   region.ID,
+				"name": region.Name,
+			},
+			"versions":  toAny(i.versions),
+			"clusters":  toAny(i.clusters),
+			"instances": toAny(i.instances),
+		},
+	}
+}
+
+// images collects the images used by resources in the region.
+func (o *options) images(ctx context.Context, cli client.Client) ([]*image, error) {
+	regionID := o.region.Region.Name
+
+	images := map[string]*image{}
+
+	lookup := func(id string) *image {
+		if _, ok := images[id]; !ok {
+			images[id] = &image{
+				id: id,
+			}
+		}
+
+		return images[id]
+	}
+
+	add := func(list []string, value string) []string {
+		if value == "" || slices.Contains(list, value) {
+			return list
+		}
+
+		return append(list, value)
+	}
+
+	clusters := &kubernetesv1.KubernetesClusterList{}
+	if err := util.ListAllNamespaces(ctx, cli, o.UnikornFlags.IdentityNamespace, o.UnikornFlags.ServiceNamespaces(), clusters, &client.ListOptions{}); err != nil {
+		return nil, fmt.Errorf("failed to list clusters: %w", err)
+	}
+
+	for i := range clusters.Items {
+		cluster := &clusters.Items[i]
+
+		if cluster.Spec.RegionID != regionID {
+			continue
+		}
+
+		ids := []string{cluster.Spec.ControlPlane.ImageID}
+
+		for _, pool := range cluster.Spec.WorkloadPools.Pools {
+			ids = append(ids, pool.ImageID)
+		}
+
+		for _, id := range ids {
+			if id == "" {
+				continue
+			}
+
+			image := lookup(id)
+			image.versions = add(image.versions, cluster.Spec.Version.Original())
+			image.clusters = add(image.clusters, cluster.Labels[constants.NameLabel])
+		}
+	}
+
+	options := &client.ListOptions{
+		LabelSelector: labels.SelectorFromSet(labels.Set{
+			regionconstants.RegionLabel: regionID,
+		}),
+	}
+
+	instances := &computev1.ComputeInstanceList{}
+	if err := util.ListAllNamespaces(ctx, cli, o.UnikornFlags.IdentityNamespace, o.UnikornFlags.ServiceNamespaces(), instances, options); err != nil {
+		return nil, fmt.Errorf("failed to list compute instances: %w", err)
+	}
+
+	for i := range instances.Items {
+		instance := &instances.Items[i]
+
+		if instance.Spec.ImageID == "" {
+			continue
+		}
+
+		image := lookup(instance.Spec.ImageID)
+		image.instances = add(image.instances, instance.Labels[constants.NameLabel])
+	}
+
+	result := make([]*image, 0, len(images))
+
+	for _, id := range slices.Sorted(maps.Keys(images)) {
+		image := images[id]
+
+		slices.Sort(image.versions)
+		slices.Sort(image.clusters)
+		slices.Sort(image.instances)
+
+		result = append(result, image)
+	}
+
+	return result, nil
+}
+
+func (o *options) execute(ctx context.Context, cli client.Client) error {
+	listing, err := o.list(ctx, cli)
+	if err != nil {
+		return err
+	}
+
+	return o.PrintFlags.PrintListing(os.Stdout, listing)
+}
+
+func (o *options) list(ctx context.Context, cli client.Client) (*printer.Listing, error) {
+	images, err := o.images(ctx, cli)
+	if err != nil {
+		return nil, err
+	}
+
+	if o.PrintFlags.Structured() {
+		region := &printer.Reference{
+			ID:   o.region.Region.Name,
+			Name: o.region.Region.Labels[constants.NameLabel],
+		}
+
+		objects := make([]unstructured.Unstructured, len(images))
+
+		for i := range images {
+			objects[i] = images[i].object(region)
+		}
+
+		return &printer.Listing{Objects: objects}, nil
+	}
+
+	// Build headers from selected columns
+	headerMap := map[string]string{
+		"id":        "ID",
+		"versions":  "Kubernetes Versions",
+		"clusters":  "Clusters",
+		"instances": "Instances",
+	}
+
+	headers := make([]string, 0, len(o.columns))
+	for _, col := range o.columns {
+		headers = append(headers, headerMap[col])
+	}
+
+	t := printer.NewTable(headers...)
+
+	for _, image := range images {
+		// Build row values in column order
+		valueMap := map[string]string{
+			"id":        image.id,
+			"versions":  strings.Join(image.versions, ", "),
+			"clusters":  strconv.Itoa(len(image.clusters)),
+			"instances": strconv.Itoa(len(image.instances)),
+		}
+
+		var row []string
+		for _, col := range o.columns {
+			row = append(row, valueMap[col])
+		}
+
+		t.Row(row...)
+	}
+
+	return &printer.Listing{Table: t}, nil
+}
@@ -0,0 +1,195 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubeconfig
+
+import (
+	"context"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/nscaledev/unicli/pkg/factory"
+	"github.com/nscaledev/unicli/pkg/flags"
+	"github.com/nscaledev/unicli/pkg/kubeconfig"
+	"github.com/nscaledev/unicli/pkg/resolve"
+	"github.com/nscaledev/unicli/pkg/vcluster"
+
+	"k8s.io/client-go/rest"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+type options struct {
+	UnikornFlags *factory.UnikornFlags
+	newClient    vcluster.NewClientFunc
+
+	organization *flags.OrganizationFlags
+	project      *flags.ProjectFlags
+	kubeconfig   *flags.KubeconfigFlags
+	virtual      bool
+}
+
+func (o *options) AddFlags(cmd *cobra.Command, factory *factory.Factory) error {
+	if err := o.organization.AddFlags(cmd, factory, false); err != nil {
+		return err
+	}
+
+	if err := o.project.AddFlags(cmd, factory, false); err != nil {
+		return err
+	}
+
+	o.kubeconfig.AddFlags(cmd)
+
+	cmd.Flags().BoolVar(&o.virtual, "virtual", false, "Get the kubeconfig for a virtual kubernetes cluster.")
+
+	return nil
+}
+
+func (o *options) validate(ctx context.Context, cli client.Client) error {
+	validators := []func(context.Context, client.Client) error{
+		o.organization.Validate,
+		o.project.Validate,
+	}
+
+	for _, validator := range validators {
+		if err := validator(ctx, cli); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (o *options) execute(ctx context.Context, cli client.Client, config *rest.Config, identifier string) error {
+	scope := flags.Scope(o.UnikornFlags, o.organization, o.project)
+
+	var cluster client.Object
+
+	var clusterConfig *clientcmdapi.Config
+
+	if o.virtual {
+		resource, err := resolve.VirtualKubernetesCluster(ctx, cli, scope, identifier)
+		if err != nil {
+			return err
+		}
+
+		if clusterConfig, err = kubeconfig.VirtualKubernetesCluster(ctx, cli, o.newClient, o.UnikornFlags.RegionNamespace, resource); err != nil {
+			return err
+		}
+
+		cluster = resource
+	} else {
+		resource, err := resolve.KubernetesCluster(ctx, cli, scope, identifier)
+		if err != nil {
+			return err
+		}
+
+		if clusterConfig, err = kubeconfig.KubernetesCluster(ctx, cli, config, o.newClient, resource); err != nil {
+			return err
+		}
+
+		cluster = resource
+	}
+
+	name, err := kubeconfig.ContextName(ctx, cli, o.UnikornFlags.IdentityNamespace, cluster)
+	if err != nil {
+		return err
+	}
+
+	clusterConfig, err = kubeconfig.Rename(clusterConfig, name)
+	if err != nil {
+		return err
+	}
+
+	return o.kubeconfig.Output(os.Stdout, clusterConfig)
+}
+
+func Command(factory *factory.Factory) *cobra.Command {
+	unikornFlags := &factory.UnikornFlags
+	organizationFlags := flags.NewOrganizationFlags(unikornFlags)
+	projectFlags := flags.NewProjectFlags(unikornFlags, organizationFlags)
+
+	o := options{
+		UnikornFlags: unikornFlags,
+		newClient:    factory.ConfigClient,
+		organization: organizationFlags,
+		project:      projectFlags,
+		kubeconfig:   flags.NewKubeconfigFlags(false),
+	}
+
+	cmd := &cobra.Command{
+		Use:   "kubeconfig <cluster>",
+		Short: "Get the kubeconfig for a kubernetes cluster",
+		Long: `Get the kubeconfig for a kubernetes cluster or virtual kubernetes cluster.
+
+The cluster may be specified by either name or ID.  By default the kubeconfig
+is printed, it may instead be written to a file, or merged into your kubeconfig
+with a context named <organization>/<project>/<cluster>.
+
+Examples:
+  # Print the kubeconfig for a kubernetes cluster
+  unicli get kubeconfig my-cluster
+
+  # Write the kubeconfig for a virtual kubernetes cluster to a file
+  unicli get kubeconfig my-cluster --virtual --file my-cluster.kubeconfig
+
+  # Merge the kubeconfig into ~/.kube/config
+  unicli get kubeconfig my-cluster --merge`,
+		Args: cobra.ExactArgs(1),
+		ValidArgsFunction: func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+			if len(args) != 0 {
+				return nil, cobra.ShellCompDirectiveNoFileComp
+			}
+
+			if o.virtual {
+				return factory.VirtualKubernetesClusterNameCompletionFunc(&organizationFlags.OrganizationName, &projectFlags.ProjectName)(cmd, args, toComplete)
+			}
+
+			return factory.KubernetesClusterNameCompletionFunc(&organizationFlags.OrganizationName, &projectFlags.ProjectName)(cmd, args, toComplete)
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			config, err := factory.RESTConfig()
+			if err != nil {
+				return err
+			}
+
+			client, err := factory.Client(ctx)
+			if err != nil {
+				return err
+			}
+
+			if err := o.validate(ctx, client); err != nil {
+				return err
+			}
+
+			if err := o.execute(ctx, client, config, args[0]); err != nil {
+				return err
+			}
+
+			return nil
+		},
+	}
+
+	if err := o.AddFlags(cmd, factory); err != nil {
+		panic(err)
+	}
+
+	return cmd
+}
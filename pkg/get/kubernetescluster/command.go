@@ -19,21 +19,20 @@ package kubernetescluster
 import (
 	"context"
 	"fmt"
+	"os"
 	"slices"
 	"strings"
-	"time"
 
-	"github.com/charmbracelet/lipgloss"
-	"github.com/charmbracelet/lipgloss/table"
 	"github.com/spf13/cobra"
 
 	"github.com/nscaledev/unicli/pkg/factory"
 	"github.com/nscaledev/unicli/pkg/flags"
+	"github.com/nscaledev/unicli/pkg/printer"
 	"github.com/unikorn-cloud/core/pkg/constants"
 	kubernetesv1 "github.com/unikorn-cloud/kubernetes/pkg/apis/unikorn/v1alpha1"
 	regionv1 "github.com/unikorn-cloud/region/pkg/apis/unikorn/v1alpha1"
 
-	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/labels"
 
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -49,11 +48,14 @@ var defaultColumns = []string{"name", "version", "status", "organization", "proj
 
 type options struct {
 	UnikornFlags *factory.UnikornFlags
+	PrintFlags   *printer.Flags
 
 	organization *flags.OrganizationFlags
 	project      *flags.ProjectFlags
 	region       *flags.RegionFlags
+	watch        *flags.WatchFlags
 	columns      []string
+	contexts     *flags.ContextsFlags
 }
 
 func (o *options) AddFlags(cmd *cobra.Command, factory *factory.Factory) error {
@@ -72,9 +74,28 @@ func (o *options) AddFlags(cmd *cobra.Command, factory *factory.Factory) error {
 	cmd.Flags().StringSliceVar(&o.columns, "columns", defaultColumns,
 		fmt.Sprintf("Comma-separated list of columns to display. Available: %s", strings.Join(allColumns, ", ")))
 
+	o.watch.AddFlags(cmd)
+
+	if err := o.contexts.AddFlags(cmd, factory); err != nil {
+		return err
+	}
+
 	return nil
 }
 
+// clone returns a copy of the options that can be validated against another
+// management cluster, flags are resolved to resources specific to each cluster.
+func (o *options) clone() *options {
+	organization := o.organization.Clone()
+
+	clone := *o
+	clone.organization = organization
+	clone.project = o.project.Clone(organization)
+	clone.region = o.region.Clone()
+
+	return &clone
+}
+
 func (o *options) validate(ctx context.Context, cli client.Client) error {
 	validators := []func(context.Context, client.Client) error{
 		o.organization.Validate,
@@ -88,6 +109,10 @@ func (o *options) validate(ctx context.Context, cli client.Client) error {
 		}
 	}
 
+	if o.PrintFlags.Wide() && slices.Equal(o.columns, defaultColumns) {
+		o.columns = allColumns
+	}
+
 	for _, col := range o.columns {
 		if !slices.Contains(allColumns, strings.ToLower(col)) {
 			return fmt.Errorf("unknown column %q, available columns: %s", col, strings.Join(allColumns, ", "))
@@ -105,9 +130,12 @@ func Command(factory *factory.Factory) *cobra.Command {
 
 	o := options{
 		UnikornFlags: unikornFlags,
+		PrintFlags:   &factory.PrintFlags,
 		organization: organizationFlags,
 		project:      projectFlags,
 		region:       regionFlags,
+		watch:        flags.NewWatchFlags(&factory.PrintFlags),
+		contexts:     &flags.ContextsFlags{},
 	}
 
 	cmd := &cobra.Command{
@@ -118,10 +146,25 @@ func Command(factory *factory.Factory) *cobra.Command {
 			"kc",
 		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
-			defer cancel()
+			ctx := cmd.Context()
 
-			client, err := factory.Client()
+			if err := o.contexts.Validate(cmd, factory, o.watch); err != nil {
+				return err
+			}
+
+			if o.contexts.Enabled() {
+				return o.contexts.Run(ctx, factory, func(ctx context.Context, cli client.Client) (*printer.Listing, error) {
+					clone := o.clone()
+
+					if err := clone.validate(ctx, cli); err != nil {
+						return nil, err
+					}
+
+					return clone.list(ctx, cli)
+				})
+			}
+
+			client, err := factory.Client(ctx)
 			if err != nil {
 				return err
 			}
@@ -130,7 +173,11 @@ func Command(factory *factory.Factory) *cobra.Command {
 				return err
 			}
 
-			if err := o.execute(ctx, client, args); err != nil {
+			if o.watch.Watch {
+				return o.watch.Run(ctx, factory, client, &kubernetesv1.KubernetesCluster{}, o.selector(), o.execute)
+			}
+
+			if err := o.execute(ctx, client); err != nil {
 				return err
 			}
 
@@ -186,7 +233,8 @@ func getClusterDetails(cluster *kubernetesv1.KubernetesCluster, orgNames, projec
 	}
 }
 
-func (o *options) execute(ctx context.Context, cli client.Client, args []string) error {
+// selector returns the labels listed resources must match.
+func (o *options) selector() labels.Selector {
 	l := labels.Set{}
 
 	if o.organization.Organization != nil {
@@ -197,28 +245,30 @@ func (o *options) execute(ctx context.Context, cli client.Client, args []string)
 		l[constants.ProjectLabel] = o.project.Project.Name
 	}
 
-	namespaces := &corev1.NamespaceList{}
-	if err := cli.List(ctx, namespaces); err != nil {
-		return fmt.Errorf("failed to list namespaces: %w", err)
-	}
+	return labels.SelectorFromSet(l)
+}
 
-	// Collect all clusters across namespaces
-	var allClusters []kubernetesv1.KubernetesCluster
+func (o *options) execute(ctx context.Context, cli client.Client) error {
+	listing, err := o.list(ctx, cli)
+	if err != nil {
+		return err
+	}
 
-	for _, namespace := range namespaces.Items {
-		options := &client.ListOptions{
-			LabelSelector: labels.SelectorFromSet(l),
-			Namespace:     namespace.Name,
-		}
+	return o.PrintFlags.PrintListing(os.Stdout, listing)
+}
 
-		resources := &kubernetesv1.KubernetesClusterList{}
-		if err := cli.List(ctx, resources, options); err != nil {
-			return fmt.Errorf("failed to list clusters in namespace %s: %w", namespace.Name, err)
-		}
+func (o *options) list(ctx context.Context, cli client.Client) (*printer.Listing, error) {
+	options := &client.ListOptions{
+		LabelSelector: o.selector(),
+	}
 
-		allClusters = append(allClusters, resources.Items...)
+	resources := &kubernetesv1.KubernetesClusterList{}
+	if err := util.ListAllNamespaces(ctx, cli, o.UnikornFlags.IdentityNamespace, o.UnikornFlags.ServiceNamespaces(), resources, options); err != nil {
+		return nil, fmt.Errorf("failed to list clusters: %w", err)
 	}
 
+	allClusters := resources.Items
+
 	// Filter by region if specified
 	if o.region.Region != nil {
 		filtered := allClusters[:0]
@@ -233,23 +283,46 @@ func (o *options) execute(ctx context.Context, cli client.Client, args []string)
 	// Create maps for ID to name lookups
 	orgNames, err := util.CreateOrganizationNameMap(ctx, cli, o.UnikornFlags.IdentityNamespace)
 	if err != nil {
-		return fmt.Errorf("failed to list organizations: %w", err)
+		return nil, fmt.Errorf("failed to list organizations: %w", err)
 	}
 
 	projectNames, err := util.CreateProjectNameMap(ctx, cli)
 	if err != nil {
-		return fmt.Errorf("failed to list projects: %w", err)
+		return nil, fmt.Errorf("failed to list projects: %w", err)
 	}
 
 	regions := &regionv1.RegionList{}
 	if err := cli.List(ctx, regions, &client.ListOptions{Namespace: o.UnikornFlags.RegionNamespace}); err != nil {
-		return fmt.Errorf("failed to list regions: %w", err)
+		return nil, fmt.Errorf("failed to list regions: %w", err)
 	}
 	regionNames := make(map[string]string)
 	for _, region := range regions.Items {
 		regionNames[region.Name] = region.Labels[constants.NameLabel]
 	}
 
+	if o.PrintFlags.Structured() {
+		objects := make([]unstructured.Unstructured, len(allClusters))
+
+		for i := range allClusters {
+			resource := &allClusters[i]
+
+			references := map[string]printer.Reference{
+				"organization": printer.NewReference(orgNames, resource.Labels[constants.OrganizationLabel]),
+				"project":      printer.NewReference(projectNames, resource.Labels[constants.ProjectLabel]),
+				"region":       printer.NewReference(regionNames, resource.Spec.RegionID),
+			}
+
+			object, err := printer.NewObject(cli.Scheme(), resource, references)
+			if err != nil {
+				return nil, err
+			}
+
+			objects[i] = *object
+		}
+
+		return &printer.Listing{Objects: objects}, nil
+	}
+
 	// Build headers from selected columns
 	headerMap := map[string]string{
 		"name":         "Name",
@@ -267,20 +340,7 @@ func (o *options) execute(ctx context.Context, cli client.Client, args []string)
 	}
 
 	// Create table
-	t := table.New().
-		Border(lipgloss.RoundedBorder()).
-		BorderStyle(lipgloss.NewStyle().Foreground(lipgloss.Color("#1E3A8A"))).
-		Headers(headers...).
-		StyleFunc(func(row, col int) lipgloss.Style {
-			if row == table.HeaderRow {
-				return lipgloss.NewStyle().
-					Bold(true).
-					Foreground(lipgloss.Color("#FAFAFA")).
-					Background(lipgloss.Color("#1E3A8A")).
-					Padding(0, 1)
-			}
-			return lipgloss.NewStyle()
-		})
+	t := printer.NewTable(headers...)
 
 	// Add rows
 	for i := range allClusters {
@@ -312,7 +372,5 @@ func (o *options) execute(ctx context.Context, cli client.Client, args []string)
 		t.Row(row...)
 	}
 
-	// Print the table
-	fmt.Println(t)
-	return nil
+	return &printer.Listing{Table: t}, nil
 }
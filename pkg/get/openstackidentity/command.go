@@ -19,25 +19,27 @@ package openstackidentity
 import (
 	"context"
 	"fmt"
+	"os"
 	"sort"
 	"strings"
-	"time"
 
-	"github.com/charmbracelet/lipgloss"
-	"github.com/charmbracelet/lipgloss/table"
 	"github.com/spf13/cobra"
 
 	"github.com/nscaledev/unicli/pkg/errors"
 	"github.com/nscaledev/unicli/pkg/factory"
+	"github.com/nscaledev/unicli/pkg/printer"
 	"github.com/nscaledev/unicli/pkg/util"
 	"github.com/unikorn-cloud/core/pkg/constants"
 	regionv1 "github.com/unikorn-cloud/region/pkg/apis/unikorn/v1alpha1"
 
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 type options struct {
 	UnikornFlags *factory.UnikornFlags
+	PrintFlags   *printer.Flags
 }
 
 func (o *options) AddFlags(cmd *cobra.Command, factory *factory.Factory) error {
@@ -71,6 +73,7 @@ func (o *options) execute(ctx context.Context, cli client.Client, args []string)
 
 	// Create a slice to hold all rows for sorting
 	type rowData struct {
+		identity    *regionv1.OpenstackIdentity
 		identityID  string
 		clusterID   string
 		clusterName string
@@ -79,10 +82,13 @@ func (o *options) execute(ctx context.Context, cli client.Client, args []string)
 
 	if len(args) > 0 {
 		// Show specific identity
-		for _, resource := range resources.Items {
+		for i := range resources.Items {
+			resource := &resources.Items[i]
+
 			if resource.Labels[constants.NameLabel] == args[0] {
 				clusterName := strings.TrimPrefix(resource.Labels[constants.NameLabel], "kubernetes-cluster-")
 				rows = append(rows, rowData{
+					identity:    resource,
 					identityID:  resource.Name,
 					clusterID:   clusterName,
 					clusterName: clusterNames[clusterName],
@@ -92,9 +98,12 @@ func (o *options) execute(ctx context.Context, cli client.Client, args []string)
 		}
 	} else {
 		// Show all identities
-		for _, resource := range resources.Items {
+		for i := range resources.Items {
+			resource := &resources.Items[i]
+
 			clusterName := strings.TrimPrefix(resource.Labels[constants.NameLabel], "kubernetes-cluster-")
 			rows = append(rows, rowData{
+				identity:    resource,
 				identityID:  resource.Name,
 				clusterID:   clusterName,
 				clusterName: clusterNames[clusterName],
@@ -107,21 +116,27 @@ func (o *options) execute(ctx context.Context, cli client.Client, args []string)
 		return rows[i].identityID < rows[j].identityID
 	})
 
-	// Create table
-	t := table.New().
-		Border(lipgloss.RoundedBorder()).
-		BorderStyle(lipgloss.NewStyle().Foreground(lipgloss.Color("#1E3A8A"))).
-		Headers("OpenStack Identity ID", "Kubernetes Cluster ID", "Kubernetes Cluster Name").
-		StyleFunc(func(row, col int) lipgloss.Style {
-			if row == table.HeaderRow {
-				return lipgloss.NewStyle().
-					Bold(true).
-					Foreground(lipgloss.Color("#FAFAFA")).
-					Background(lipgloss.Color("#1E3A8A")).
-					Padding(0, 1)
+	if o.PrintFlags.Structured() {
+		objects := make([]unstructured.Unstructured, len(rows))
+
+		for i, row := range rows {
+			references := map[string]printer.Reference{
+				"kubernetescluster": printer.NewReference(clusterNames, row.clusterID),
+			}
+
+			object, err := printer.NewObject(cli.Scheme(), row.identity, references)
+			if err != nil {
+				return err
 			}
-			return lipgloss.NewStyle()
-		})
+
+			objects[i] = *object
+		}
+
+		return o.PrintFlags.PrintListing(os.Stdout, &printer.Listing{Objects: objects})
+	}
+
+	// Create table
+	t := printer.NewTable("OpenStack Identity ID", "Kubernetes Cluster ID", "Kubernetes Cluster Name")
 
 	// Add sorted rows to table
 	for _, row := range rows {
@@ -133,13 +148,13 @@ func (o *options) execute(ctx context.Context, cli client.Client, args []string)
 	}
 
 	// Print the table
-	fmt.Println(t)
-	return nil
+	return o.PrintFlags.PrintListing(os.Stdout, &printer.Listing{Table: t})
 }
 
 func Command(factory *factory.Factory) *cobra.Command {
 	o := options{
 		UnikornFlags: &factory.UnikornFlags,
+		PrintFlags:   &factory.PrintFlags,
 	}
 
 	cmd := &cobra.Command{
@@ -158,10 +173,9 @@ Examples:
   # Get information about a specific OpenStack identity
   kubectl unikorn get openstackidentity my-identity`,
 		RunE: func(cmd *cobra.Command, args []string) error {
-			ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
-			defer cancel()
+			ctx := cmd.Context()
 
-			client, err := factory.Client()
+			client, err := factory.Client(ctx)
 			if err != nil {
 				return err
 			}
@@ -0,0 +1,232 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package organization
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/nscaledev/unicli/pkg/factory"
+	"github.com/nscaledev/unicli/pkg/flags"
+	"github.com/nscaledev/unicli/pkg/printer"
+	"github.com/unikorn-cloud/core/pkg/constants"
+	identityv1 "github.com/unikorn-cloud/identity/pkg/apis/unikorn/v1alpha1"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// allColumns defines every available column name.
+var allColumns = []string{"name", "id", "namespace", "domain", "status"}
+
+// defaultColumns is the set shown when --columns is not specified.
+var defaultColumns = []string{"name", "namespace", "status"}
+
+type options struct {
+	UnikornFlags *factory.UnikornFlags
+	PrintFlags   *printer.Flags
+
+	watch    *flags.WatchFlags
+	columns  []string
+	contexts *flags.ContextsFlags
+}
+
+func (o *options) AddFlags(cmd *cobra.Command, factory *factory.Factory) error {
+	cmd.Flags().StringSliceVar(&o.columns, "columns", defaultColumns,
+		fmt.Sprintf("Comma-separated list of columns to display. Available: %s", strings.Join(allColumns, ", ")))
+
+	o.watch.AddFlags(cmd)
+
+	if err := o.contexts.AddFlags(cmd, factory); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// clone returns a copy of the options that can be validated against another
+// management cluster.
+func (o *options) clone() *options {
+	clone := *o
+
+	return &clone
+}
+
+func (o *options) validate(ctx context.Context, cli client.Client) error {
+	if o.PrintFlags.Wide() && slices.Equal(o.columns, defaultColumns) {
+		o.columns = allColumns
+	}
+
+	for _, col := range o.columns {
+		if !slices.Contains(allColumns, strings.ToLower(col)) {
+			return fmt.Errorf("unknown column %q, available columns: %s", col, strings.Join(allColumns, ", "))
+		}
+	}
+
+	return nil
+}
+
+func Command(factory *factory.Factory) *cobra.Command {
+	o := options{
+		UnikornFlags: &factory.UnikornFlags,
+		PrintFlags:   &factory.PrintFlags,
+		watch:        flags.NewWatchFlags(&factory.PrintFlags),
+		contexts:     &flags.ContextsFlags{},
+	}
+
+	cmd := &cobra.Command{
+		Use:   "organization",
+		Short: "Get organizations",
+		Aliases: []string{
+			"organizations",
+			"org",
+			"orgs",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			if err := o.contexts.Validate(cmd, factory, o.watch); err != nil {
+				return err
+			}
+
+			if o.contexts.Enabled() {
+				return o.contexts.Run(ctx, factory, func(ctx context.Context, cli client.Client) (*printer.Listing, error) {
+					clone := o.clone()
+
+					if err := clone.validate(ctx, cli); err != nil {
+						return nil, err
+					}
+
+					return clone.list(ctx, cli)
+				})
+			}
+
+			client, err := factory.Client(ctx)
+			if err != nil {
+				return err
+			}
+
+			if err := o.validate(ctx, client); err != nil {
+				return err
+			}
+
+			if o.watch.Watch {
+				return o.watch.Run(ctx, factory, client, &identityv1.Organization{}, labels.Everything(), o.execute)
+			}
+
+			if err := o.execute(ctx, client); err != nil {
+				return err
+			}
+
+			return nil
+		},
+	}
+
+	if err := o.AddFlags(cmd, factory); err != nil {
+		panic(err)
+	}
+
+	return cmd
+}
+
+func (o *options) execute(ctx context.Context, cli client.Client) error {
+	listing, err := o.list(ctx, cli)
+	if err != nil {
+		return err
+	}
+
+	return o.PrintFlags.PrintListing(os.Stdout, listing)
+}
+
+func (o *options) list(ctx context.Context, cli client.Client) (*printer.Listing, error) {
+	resources := &identityv1.OrganizationList{}
+	if err := cli.List(ctx, resources, &client.ListOptions{Namespace: o.UnikornFlags.IdentityNamespace}); err != nil {
+		return nil, fmt.Errorf("failed to list organizations: %w", err)
+	}
+
+	allOrganizations := resources.Items
+
+	if o.PrintFlags.Structured() {
+		objects := make([]unstructured.Unstructured, len(allOrganizations))
+
+		for i := range allOrganizations {
+			object, err := printer.NewObject(cli.Scheme(), &allOrganizations[i], nil)
+			if err != nil {
+				return nil, err
+			}
+
+			objects[i] = *object
+		}
+
+		return &printer.Listing{Objects: objects}, nil
+	}
+
+	// Build headers from selected columns
+	headerMap := map[string]string{
+		"name":      "Name",
+		"id":        "ID",
+		"namespace": "Namespace",
+		"domain":    "Domain",
+		"status":    "Status",
+	}
+
+	headers := make([]string, 0, len(o.columns))
+	for _, col := range o.columns {
+		headers = append(headers, headerMap[col])
+	}
+
+	t := printer.NewTable(headers...)
+
+	for i := range allOrganizations {
+		resource := &allOrganizations[i]
+
+		domain := ""
+		if resource.Spec.Domain != nil {
+			domain = *resource.Spec.Domain
+		}
+
+		statusReason := ""
+		if len(resource.Status.Conditions) > 0 {
+			statusReason = string(resource.Status.Conditions[0].Reason)
+		}
+
+		// Build row values in column order
+		valueMap := map[string]string{
+			"name":      resource.Labels[constants.NameLabel],
+			"id":        resource.Name,
+			"namespace": resource.Status.Namespace,
+			"domain":    domain,
+			"status":    statusReason,
+		}
+
+		var row []string
+		for _, col := range o.columns {
+			row = append(row, valueMap[col])
+		}
+
+		t.Row(row...)
+	}
+
+	return &printer.Listing{Table: t}, nil
+}
@@ -0,0 +1,255 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package role
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/nscaledev/unicli/pkg/factory"
+	"github.com/nscaledev/unicli/pkg/flags"
+	"github.com/nscaledev/unicli/pkg/printer"
+	"github.com/unikorn-cloud/core/pkg/constants"
+	identityv1 "github.com/unikorn-cloud/identity/pkg/apis/unikorn/v1alpha1"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// allColumns defines every available column name.
+var allColumns = []string{"name", "id", "protected", "scopes", "global", "organization", "project"}
+
+// defaultColumns is the set shown when --columns is not specified.
+var defaultColumns = []string{"name", "protected", "scopes"}
+
+type options struct {
+	UnikornFlags *factory.UnikornFlags
+	PrintFlags   *printer.Flags
+
+	columns  []string
+	contexts *flags.ContextsFlags
+}
+
+func (o *options) AddFlags(cmd *cobra.Command, factory *factory.Factory) error {
+	cmd.Flags().StringSliceVar(&o.columns, "columns", defaultColumns,
+		fmt.Sprintf("Comma-separated list of columns to display. Available: %s", strings.Join(allColumns, ", ")))
+
+	if err := o.contexts.AddFlags(cmd, factory); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// clone returns a copy of the options that can be validated against another
+// management cluster.
+func (o *options) clone() *options {
+	clone := *o
+
+	return &clone
+}
+
+func (o *options) validate(ctx context.Context, cli client.Client) error {
+	if o.PrintFlags.Wide() && slices.Equal(o.columns, defaultColumns) {
+		o.columns = allColumns
+	}
+
+	for _, col := range o.columns {
+		if !slices.Contains(allColumns, strings.ToLower(col)) {
+			return fmt.Errorf("unknown column %q, available columns: %s", col, strings.Join(allColumns, ", "))
+		}
+	}
+
+	return nil
+}
+
+func Command(factory *factory.Factory) *cobra.Command {
+	o := options{
+		UnikornFlags: &factory.UnikornFlags,
+		PrintFlags:   &factory.PrintFlags,
+		contexts:     &flags.ContextsFlags{},
+	}
+
+	cmd := &cobra.Command{
+		Use:   "role",
+		Short: "Get roles",
+		Aliases: []string{
+			"roles",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			if err := o.contexts.Validate(cmd, factory, nil); err != nil {
+				return err
+			}
+
+			if o.contexts.Enabled() {
+				return o.contexts.Run(ctx, factory, func(ctx context.Context, cli client.Client) (*printer.Listing, error) {
+					clone := o.clone()
+
+					if err := clone.validate(ctx, cli); err != nil {
+						return nil, err
+					}
+
+					return clone.list(ctx, cli)
+				})
+			}
+
+			client, err := factory.Client(ctx)
+			if err != nil {
+				return err
+			}
+
+			if err := o.validate(ctx, client); err != nil {
+				return err
+			}
+
+			if err := o.execute(ctx, client); err != nil {
+				return err
+			}
+
+			return nil
+		},
+	}
+
+	if err := o.AddFlags(cmd, factory); err != nil {
+		panic(err)
+	}
+
+	return cmd
+}
+
+func (o *options) execute(ctx context.Context, cli client.Client) error {
+	listing, err := o.list(ctx, cli)
+	if err != nil {
+		return err
+	}
+
+	return o.PrintFlags.PrintListing(os.Stdout, listing)
+}
+
+// scopeSummary returns the number of scopes granted at each level.
+func scopeSummary(scopes *identityv1.RoleScopes) string {
+	levels := []struct {
+		name   string
+		scopes []identityv1.RoleScope
+	}{
+		{name: "global", scopes: scopes.Global},
+		{name: "organization", scopes: scopes.Organization},
+		{name: "project", scopes: scopes.Project},
+	}
+
+	var summary []string
+
+	for _, level := range levels {
+		if len(level.scopes) != 0 {
+			summary = append(summary, fmt.Sprintf("%d %s", len(level.scopes), level.name))
+		}
+	}
+
+	return strings.Join(summary, ", ")
+}
+
+// scopeList returns each scope and its permitted operations e.g. "identity:projects(create,read)".
+func scopeList(scopes []identityv1.RoleScope) string {
+	result := make([]string, len(scopes))
+
+	for i, scope := range scopes {
+		operations := make([]string, len(scope.Operations))
+
+		for j, operation := range scope.Operations {
+			operations[j] = string(operation)
+		}
+
+		result[i] = fmt.Sprintf("%s(%s)", scope.Name, strings.Join(operations, ","))
+	}
+
+	return strings.Join(result, ", ")
+}
+
+func (o *options) list(ctx context.Context, cli client.Client) (*printer.Listing, error) {
+	resources := &identityv1.RoleList{}
+	if err := cli.List(ctx, resources, &client.ListOptions{Namespace: o.UnikornFlags.IdentityNamespace}); err != nil {
+		return nil, fmt.Errorf("failed to list roles: %w", err)
+	}
+
+	allRoles := resources.Items
+
+	if o.PrintFlags.Structured() {
+		objects := make([]unstructured.Unstructured, len(allRoles))
+
+		for i := range allRoles {
+			object, err := printer.NewObject(cli.Scheme(), &allRoles[i], nil)
+			if err != nil {
+				return nil, err
+			}
+
+			objects[i] = *object
+		}
+
+		return &printer.Listing{Objects: objects}, nil
+	}
+
+	// Build headers from selected columns
+	headerMap := map[string]string{
+		"name":         "Name",
+		"id":           "ID",
+		"protected":    "Protected",
+		"scopes":       "Scopes",
+		"global":       "Global",
+		"organization": "Organization",
+		"project":      "Project",
+	}
+
+	headers := make([]string, 0, len(o.columns))
+	for _, col := range o.columns {
+		headers = append(headers, headerMap[col])
+	}
+
+	t := printer.NewTable(headers...)
+
+	for i := range allRoles {
+		resource := &allRoles[i]
+
+		// Build row values in column order
+		valueMap := map[string]string{
+			"name":         resource.Labels[constants.NameLabel],
+			"id":           resource.Name,
+			"protected":    strconv.FormatBool(resource.Spec.Protected),
+			"scopes":       scopeSummary(&resource.Spec.Scopes),
+			"global":       scopeList(resource.Spec.Scopes.Global),
+			"organization": scopeList(resource.Spec.Scopes.Organization),
+			"project":      scopeList(resource.Spec.Scopes.Project),
+		}
+
+		var row []string
+		for _, col := range o.columns {
+			row = append(row, valueMap[col])
+		}
+
+		t.Row(row...)
+	}
+
+	return &printer.Listing{Table: t}, nil
+}
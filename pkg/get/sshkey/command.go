@@ -20,13 +20,12 @@ import (
 	"context"
 	"fmt"
 	"strings"
-	"time"
 
 	"github.com/spf13/cobra"
 
 	"github.com/nscaledev/unicli/pkg/errors"
 	"github.com/nscaledev/unicli/pkg/factory"
-	"github.com/nscaledev/unicli/pkg/util"
+	"github.com/nscaledev/unicli/pkg/resolve"
 	"github.com/unikorn-cloud/core/pkg/constants"
 	regionv1 "github.com/unikorn-cloud/region/pkg/apis/unikorn/v1alpha1"
 
@@ -51,36 +50,13 @@ func (o *options) validate(_ context.Context, _ client.Client) error {
 }
 
 func (o *options) execute(ctx context.Context, cli client.Client) error {
-	var resolvedClusterID string
-
-	// Retrieve all cluster names and IDs to perform the lookup.
-	clusterNameMap, err := util.CreateKubernetesClusterNameMap(ctx, cli, "", "")
+	cluster, err := resolve.KubernetesCluster(ctx, cli, &resolve.Scope{IdentityNamespace: o.UnikornFlags.IdentityNamespace}, o.clusterIdentifier)
 	if err != nil {
-		return fmt.Errorf("failed to get cluster names: %w", err)
+		return err
 	}
 
-	// Attempt to resolve the identifier as a name first.
-	foundAsName := false
-	for id, name := range clusterNameMap {
-		if name == o.clusterIdentifier {
-			resolvedClusterID = id
-			foundAsName = true
-			break
-		}
-	}
-
-	if !foundAsName {
-		// If not found as a name, assume the identifier is an ID.
-		// Validate that this ID exists in our map of known clusters.
-		if _, idExists := clusterNameMap[o.clusterIdentifier]; idExists {
-			resolvedClusterID = o.clusterIdentifier
-		} else {
-			// The identifier is neither a known name nor a known ID.
-			return fmt.Errorf("%w: cluster '%s' not found. Please provide a valid cluster name or ID", errors.ErrValidation, o.clusterIdentifier)
-		}
-	}
+	resolvedClusterID := cluster.Name
 
-	// Now, resolvedClusterID contains the validated cluster ID.
 	// Proceed to fetch the OpenStack identity.
 	resources := &regionv1.OpenstackIdentityList{}
 	if err := cli.List(ctx, resources, &client.ListOptions{Namespace: o.UnikornFlags.RegionNamespace}); err != nil {
@@ -128,10 +104,9 @@ Examples:
   unicli get sshkey my-cluster-name`,
 		Args: cobra.ExactArgs(1), // Ensures exactly one argument is provided
 		RunE: func(cmd *cobra.Command, args []string) error {
-			ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
-			defer cancel()
+			ctx := cmd.Context()
 
-			client, err := factory.Client()
+			client, err := factory.Client(ctx)
 			if err != nil {
 				return err
 			}
@@ -21,18 +21,17 @@ import (
 	"errors"
 	"fmt"
 	"os"
-	"time"
 
 	"github.com/spf13/cobra"
 
 	"github.com/nscaledev/unicli/pkg/factory"
 	"github.com/nscaledev/unicli/pkg/flags"
+	"github.com/nscaledev/unicli/pkg/printer"
 	"github.com/unikorn-cloud/core/pkg/constants"
 	identityv1 "github.com/unikorn-cloud/identity/pkg/apis/unikorn/v1alpha1"
 
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/labels"
-	"k8s.io/cli-runtime/pkg/printers"
 
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -43,6 +42,7 @@ var (
 
 type createUserOptions struct {
 	UnikornFlags *factory.UnikornFlags
+	PrintFlags   *printer.Flags
 
 	organization *flags.OrganizationFlags
 	user         *flags.UserFlags
@@ -115,23 +115,9 @@ func (o *createUserOptions) execute(ctx context.Context, cli client.Client) erro
 		return err
 	}
 
-	table := &metav1.Table{
-		ColumnDefinitions: []metav1.TableColumnDefinition{
-			{
-				Name: "namespace",
-			},
-			{
-				Name: "id",
-			},
-			{
-				Name: "email",
-			},
-			{
-				Name: "organization",
-			},
-		},
-		Rows: make([]metav1.TableRow, 0, len(organizationUsers.Items)),
-	}
+	table := printer.NewTable("Namespace", "ID", "Email", "Organization")
+
+	objects := make([]unstructured.Unstructured, 0, len(organizationUsers.Items))
 
 	for i := range organizationUsers.Items {
 		ou := &organizationUsers.Items[i]
@@ -150,17 +136,28 @@ func (o *createUserOptions) execute(ctx context.Context, cli client.Client) erro
 			return fmt.Errorf("%w: organization user %s in namespace %s doesn't have corresponding organization resource", ErrConsistency, ou.Name, ou.Namespace)
 		}
 
-		table.Rows = append(table.Rows, metav1.TableRow{
-			Cells: []interface{}{
-				ou.Namespace,
-				ou.Name,
-				user.Spec.Subject,
-				organization.Labels[constants.NameLabel],
+		references := map[string]printer.Reference{
+			"user": {
+				ID:   user.Name,
+				Name: user.Spec.Subject,
 			},
-		})
+			"organization": {
+				ID:   organization.Name,
+				Name: organization.Labels[constants.NameLabel],
+			},
+		}
+
+		object, err := printer.NewObject(cli.Scheme(), ou, references)
+		if err != nil {
+			return err
+		}
+
+		objects = append(objects, *object)
+
+		table.Row(ou.Namespace, ou.Name, user.Spec.Subject, organization.Labels[constants.NameLabel])
 	}
 
-	return printers.NewTablePrinter(printers.PrintOptions{}).PrintObj(table, os.Stdout)
+	return o.PrintFlags.PrintListing(os.Stdout, &printer.Listing{Objects: objects, Table: table})
 }
 
 func Command(factory *factory.Factory) *cobra.Command {
@@ -168,6 +165,7 @@ func Command(factory *factory.Factory) *cobra.Command {
 
 	o := createUserOptions{
 		UnikornFlags: unikornFlags,
+		PrintFlags:   &factory.PrintFlags,
 		organization: flags.NewOrganizationFlags(unikornFlags),
 		user:         flags.NewUserFlags(unikornFlags),
 	}
@@ -179,10 +177,9 @@ func Command(factory *factory.Factory) *cobra.Command {
 			"users",
 		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
-			defer cancel()
+			ctx := cmd.Context()
 
-			client, err := factory.Client()
+			client, err := factory.Client(ctx)
 			if err != nil {
 				return err
 			}
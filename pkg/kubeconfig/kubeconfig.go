@@ -0,0 +1,242 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubeconfig
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+
+	"github.com/nscaledev/unicli/pkg/errors"
+	"github.com/nscaledev/unicli/pkg/util"
+	"github.com/nscaledev/unicli/pkg/vcluster"
+	"github.com/unikorn-cloud/core/pkg/constants"
+	kubernetesv1 "github.com/unikorn-cloud/kubernetes/pkg/apis/unikorn/v1alpha1"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// KubernetesCluster returns the configuration for a kubernetes cluster.  This is
+// written by cluster API into the cluster's namespace in its cluster manager's
+// virtual cluster, which is reached via a temporary port forward.
+func KubernetesCluster(ctx context.Context, cli client.Client, config *rest.Config, newClient vcluster.NewClientFunc, cluster *kubernetesv1.KubernetesCluster) (*clientcmdapi.Config, error) {
+	pod, err := vcluster.GetPod(ctx, cli, cluster.Namespace, cluster.Spec.ClusterManagerID)
+	if err != nil {
+		return nil, err
+	}
+
+	vclusterConfig, err := vcluster.ClientConfig(ctx, cli, cluster.Namespace, cluster.Spec.ClusterManagerID)
+	if err != nil {
+		return nil, err
+	}
+
+	forward, err := vcluster.NewPortForward(ctx, config, pod)
+	if err != nil {
+		return nil, err
+	}
+
+	defer forward.Close()
+
+	vclusterClient, err := forward.Client(vclusterConfig, newClient)
+	if err != nil {
+		return nil, err
+	}
+
+	secret := &corev1.Secret{}
+
+	if err := vclusterClient.Get(ctx, client.ObjectKey{Namespace: cluster.Name, Name: kubernetesClusterSecretName(cluster)}, secret); err != nil {
+		return nil, fmt.Errorf("failed to get kubernetes cluster configuration: %w", err)
+	}
+
+	return load(secret, "value")
+}
+
+// kubernetesClusterSecretName returns the name of the secret cluster API writes
+// the kubeconfig to, this is derived from the helm release name the kubernetes
+// service uses for the cluster.
+func kubernetesClusterSecretName(cluster *kubernetesv1.KubernetesCluster) string {
+	sum := sha256.Sum256([]byte(cluster.Name))
+
+	return fmt.Sprintf("cluster-%x-kubeconfig", sum[:4])
+}
+
+// VirtualKubernetesCluster returns the configuration for a virtual kubernetes
+// cluster.  This is stored alongside the virtual cluster in the region's
+// kubernetes cluster.
+func VirtualKubernetesCluster(ctx context.Context, cli client.Client, newClient vcluster.NewClientFunc, regionNamespace string, cluster *kubernetesv1.VirtualKubernetesCluster) (*clientcmdapi.Config, error) {
+	region, err := util.GetRegion(ctx, cli, regionNamespace, cluster.Spec.RegionID)
+	if err != nil {
+		return nil, err
+	}
+
+	if region.Spec.Kubernetes == nil || region.Spec.Kubernetes.KubeconfigSecret == nil {
+		return nil, fmt.Errorf("%w: region %s is not a kubernetes region", errors.ErrResource, cluster.Spec.RegionID)
+	}
+
+	regionSecret := &corev1.Secret{}
+
+	if err := cli.Get(ctx, client.ObjectKey{Namespace: region.Namespace, Name: region.Spec.Kubernetes.KubeconfigSecret.Name}, regionSecret); err != nil {
+		return nil, fmt.Errorf("failed to get region configuration: %w", err)
+	}
+
+	regionConfig, err := load(regionSecret, "kubeconfig")
+	if err != nil {
+		return nil, err
+	}
+
+	restConfig, err := clientcmd.NewDefaultClientConfig(*regionConfig, nil).ClientConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	regionClient, err := newClient(restConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	config, err := vcluster.ClientConfig(ctx, regionClient, "virtualcluster-"+cluster.Name, cluster.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	return config, nil
+}
+
+func load(secret *corev1.Secret, key string) (*clientcmdapi.Config, error) {
+	data, ok := secret.Data[key]
+	if !ok {
+		return nil, fmt.Errorf("%w: secret %s missing key %s", errors.ErrResource, secret.Name, key)
+	}
+
+	return clientcmd.Load(data)
+}
+
+// ContextName returns a context name for a cluster, this is based on its
+// organization, project and name, so it's unique and easily recognized.
+func ContextName(ctx context.Context, cli client.Client, identityNamespace string, cluster client.Object) (string, error) {
+	organizationNames, err := util.CreateOrganizationNameMap(ctx, cli, identityNamespace)
+	if err != nil {
+		return "", err
+	}
+
+	projectNames, err := util.CreateProjectNameMap(ctx, cli)
+	if err != nil {
+		return "", err
+	}
+
+	name := func(names map[string]string, id string) string {
+		if name, ok := names[id]; ok {
+			return name
+		}
+
+		return id
+	}
+
+	l := cluster.GetLabels()
+
+	return path.Join(name(organizationNames, l[constants.OrganizationLabel]), name(projectNames, l[constants.ProjectLabel]), l[constants.NameLabel]), nil
+}
+
+// Rename gives the cluster, user and context of a single context configuration
+// the same name, so it can be merged with others without conflicts.
+func Rename(config *clientcmdapi.Config, name string) (*clientcmdapi.Config, error) {
+	current, ok := config.Contexts[config.CurrentContext]
+	if !ok {
+		return nil, fmt.Errorf("%w: configuration has no current context", errors.ErrResource)
+	}
+
+	cluster, ok := config.Clusters[current.Cluster]
+	if !ok {
+		return nil, fmt.Errorf("%w: configuration missing cluster %s", errors.ErrResource, current.Cluster)
+	}
+
+	user, ok := config.AuthInfos[current.AuthInfo]
+	if !ok {
+		return nil, fmt.Errorf("%w: configuration missing user %s", errors.ErrResource, current.AuthInfo)
+	}
+
+	renamed := clientcmdapi.NewConfig()
+	renamed.Clusters[name] = cluster
+	renamed.AuthInfos[name] = user
+	renamed.Contexts[name] = &clientcmdapi.Context{
+		Cluster:   name,
+		AuthInfo:  name,
+		Namespace: current.Namespace,
+	}
+	renamed.CurrentContext = name
+
+	return renamed, nil
+}
+
+// Write saves a configuration to a file that is only readable by the user.
+func Write(path string, config *clientcmdapi.Config) error {
+	data, err := clientcmd.Write(*config)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return err
+	}
+
+	if err := os.WriteFile(path, data, 0o600); err != nil {
+		return err
+	}
+
+	// Permissions are only applied on creation, so tighten them up for
+	// any existing file too, as it contains credentials.
+	return os.Chmod(path, 0o600)
+}
+
+// Merge adds a configuration's clusters, users and contexts to an existing
+// configuration file, replacing any with the same name.  The current context
+// is left unchanged so as not to affect other tools.
+func Merge(path string, config *clientcmdapi.Config) error {
+	existing := clientcmdapi.NewConfig()
+
+	if _, err := os.Stat(path); err == nil {
+		if existing, err = clientcmd.LoadFromFile(path); err != nil {
+			return err
+		}
+	}
+
+	for name, cluster := range config.Clusters {
+		existing.Clusters[name] = cluster
+	}
+
+	for name, user := range config.AuthInfos {
+		existing.AuthInfos[name] = user
+	}
+
+	for name, context := range config.Contexts {
+		existing.Contexts[name] = context
+	}
+
+	if existing.CurrentContext == "" {
+		existing.CurrentContext = config.CurrentContext
+	}
+
+	return Write(path, existing)
+}
@@ -0,0 +1,266 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package printer
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"github.com/nscaledev/unicli/pkg/errors"
+	"github.com/unikorn-cloud/core/pkg/constants"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/cli-runtime/pkg/printers"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+)
+
+const (
+	// OutputTable is the default human readable table or tree.
+	OutputTable = ""
+	// OutputWide is a table with all available columns.
+	OutputWide = "wide"
+	// OutputJSON emits resources as JSON.
+	OutputJSON = "json"
+	// OutputYAML emits resources as YAML.
+	OutputYAML = "yaml"
+	// OutputName emits resource kinds and names only.
+	OutputName = "name"
+	// OutputCSV emits tables as comma separated values.
+	OutputCSV = "csv"
+)
+
+// Formats is every supported output format, templated formats e.g. jsonpath
+// take their argument either inline as jsonpath=... or via --template.
+var Formats = append([]string{OutputJSON, OutputYAML, OutputName, OutputWide, OutputCSV}, genericclioptions.NewKubeTemplatePrintFlags().AllowedFormats()...)
+
+// Flags selects how a command renders its results.
+type Flags struct {
+	Output                   string
+	Template                 string
+	AllowMissingTemplateKeys bool
+}
+
+func (f *Flags) AddFlags(flags *pflag.FlagSet) {
+	flags.StringVarP(&f.Output, "output", "o", OutputTable, fmt.Sprintf("Output format. One of: %s", strings.Join(Formats, ", ")))
+	flags.StringVar(&f.Template, "template", "", "Template string or path to template file to use when -o=go-template, -o=go-template-file, -o=jsonpath or -o=jsonpath-file.")
+	flags.BoolVar(&f.AllowMissingTemplateKeys, "allow-missing-template-keys", true, "If true, ignore any errors in templates when a field or map key is missing in the template.")
+}
+
+func (f *Flags) CompletionFunc() func(*cobra.Command, []string, string) ([]string, cobra.ShellCompDirective) {
+	return func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		return Formats, cobra.ShellCompDirectiveNoFileComp
+	}
+}
+
+// Structured returns true if the output is intended for machine consumption
+// rather than a human readable table or tree.  CSV is rendered from tables, so
+// isn't considered structured.
+func (f *Flags) Structured() bool {
+	return f.output() != OutputTable && f.output() != OutputWide && f.output() != OutputCSV
+}
+
+// output returns the selected output format, like kubectl a template given
+// without an explicit output format implies a go template.
+func (f *Flags) output() string {
+	if f.Output == OutputTable && f.Template != "" {
+		return "go-template"
+	}
+
+	return f.Output
+}
+
+// CSV returns true if tables should be emitted as comma separated values.
+func (f *Flags) CSV() bool {
+	return f.Output == OutputCSV
+}
+
+// RejectCSV returns an error if CSV output is requested of a command that renders
+// a tree rather than a table, as there's nothing to emit.
+func (f *Flags) RejectCSV() error {
+	if f.CSV() {
+		return fmt.Errorf("%w: output format %s is only supported by commands that print tables", errors.ErrValidation, OutputCSV)
+	}
+
+	return nil
+}
+
+// Wide returns true if tables should display all available columns.
+func (f *Flags) Wide() bool {
+	return f.Output == OutputWide
+}
+
+// Reference is a resource referred to by another, resolved to its display name.
+type Reference struct {
+	ID   string
+	Name string
+}
+
+// NewReference creates a reference from an ID to name map, falling back to the
+// ID if the name cannot be resolved.
+func NewReference(names map[string]string, id string) Reference {
+	name := names[id]
+	if name == "" {
+		name = id
+	}
+
+	return Reference{
+		ID:   id,
+		Name: name,
+	}
+}
+
+// NewObject converts a typed resource into its structured form, adding any
+// resolved references as top level fields e.g. "organization", so consumers
+// don't need to perform their own ID to name lookups.
+func NewObject(scheme *runtime.Scheme, object client.Object, references map[string]Reference) (*unstructured.Unstructured, error) {
+	gvk, err := apiutil.GVKForObject(object, scheme)
+	if err != nil {
+		return nil, err
+	}
+
+	content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(object)
+	if err != nil {
+		return nil, err
+	}
+
+	u := &unstructured.Unstructured{
+		Object: content,
+	}
+
+	u.SetGroupVersionKind(gvk)
+	u.SetManagedFields(nil)
+
+	for field, reference := range references {
+		u.Object[field] = map[string]any{
+			"id":   reference.ID,
+			"name": reference.Name,
+		}
+	}
+
+	return u, nil
+}
+
+// displayName returns the name a user would use to refer to the resource.
+func displayName(object *unstructured.Unstructured) string {
+	if name, ok := object.GetLabels()[constants.NameLabel]; ok && name != constants.UndefinedName {
+		return name
+	}
+
+	return object.GetName()
+}
+
+func (f *Flags) printName(w io.Writer, objects []unstructured.Unstructured) error {
+	for i := range objects {
+		if _, err := fmt.Fprintf(w, "%s/%s\n", strings.ToLower(objects[i].GetKind()), displayName(&objects[i])); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// toPrinter returns a printer for the selected output format, templates are
+// handled by the same machinery kubectl uses, so the syntax is identical.
+func (f *Flags) toPrinter() (printers.ResourcePrinter, error) {
+	output := f.output()
+
+	if p, err := genericclioptions.NewJSONYamlPrintFlags().ToPrinter(output); !genericclioptions.IsNoCompatiblePrinterError(err) {
+		return p, err
+	}
+
+	templateFlags := &genericclioptions.KubeTemplatePrintFlags{
+		GoTemplatePrintFlags: &genericclioptions.GoTemplatePrintFlags{
+			TemplateArgument: &f.Template,
+			AllowMissingKeys: &f.AllowMissingTemplateKeys,
+		},
+		JSONPathPrintFlags: &genericclioptions.JSONPathPrintFlags{
+			TemplateArgument: &f.Template,
+			AllowMissingKeys: &f.AllowMissingTemplateKeys,
+		},
+		TemplateArgument: &f.Template,
+		AllowMissingKeys: &f.AllowMissingTemplateKeys,
+	}
+
+	if p, err := templateFlags.ToPrinter(output); !genericclioptions.IsNoCompatiblePrinterError(err) {
+		return p, err
+	}
+
+	return nil, fmt.Errorf("%w: unsupported output format %q, expected one of: %s", errors.ErrValidation, f.Output, strings.Join(Formats, ", "))
+}
+
+func (f *Flags) print(w io.Writer, object runtime.Object) error {
+	p, err := f.toPrinter()
+	if err != nil {
+		return err
+	}
+
+	return p.PrintObj(object, w)
+}
+
+// PrintObject prints a single resource in the selected structured format.
+func (f *Flags) PrintObject(w io.Writer, object *unstructured.Unstructured) error {
+	if f.output() == OutputName {
+		return f.printName(w, []unstructured.Unstructured{*object})
+	}
+
+	return f.print(w, object)
+}
+
+// PrintList prints a set of resources in the selected structured format.
+func (f *Flags) PrintList(w io.Writer, objects []unstructured.Unstructured) error {
+	if f.output() == OutputName {
+		return f.printName(w, objects)
+	}
+
+	list := &unstructured.UnstructuredList{
+		Object: map[string]any{
+			"apiVersion": "v1",
+			"kind":       "List",
+		},
+		Items: objects,
+	}
+
+	return f.print(w, list)
+}
+
+// PrintEvent prints a change to a resource when watching.  JSON and YAML are
+// wrapped in a watch event, like kubectl, so consumers can tell what happened,
+// other formats just print the resource.
+func (f *Flags) PrintEvent(w io.Writer, eventType string, object *unstructured.Unstructured) error {
+	if output := f.output(); output != OutputJSON && output != OutputYAML {
+		return f.PrintObject(w, object)
+	}
+
+	event := &unstructured.Unstructured{
+		Object: map[string]any{
+			"apiVersion": "meta.k8s.io/v1",
+			"kind":       "WatchEvent",
+			"type":       eventType,
+			"object":     object.Object,
+		},
+	}
+
+	return f.print(w, event)
+}
@@ -0,0 +1,143 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package printer
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/lipgloss/table"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// Table is a human readable table of resources.  Rows are collected before
+// rendering so tables from multiple management clusters can be combined.
+type Table struct {
+	Headers []string
+	Rows    [][]string
+}
+
+func NewTable(headers ...string) *Table {
+	return &Table{
+		Headers: headers,
+	}
+}
+
+// Row adds a row to the table.
+func (t *Table) Row(values ...string) {
+	t.Rows = append(t.Rows, values)
+}
+
+// String renders the table.
+func (t *Table) String() string {
+	rendered := table.New().
+		Border(lipgloss.RoundedBorder()).
+		BorderStyle(lipgloss.NewStyle().Foreground(lipgloss.Color("#1E3A8A"))).
+		Headers(t.Headers...).
+		Rows(t.Rows...).
+		StyleFunc(func(row, col int) lipgloss.Style {
+			if row == table.HeaderRow {
+				return lipgloss.NewStyle().
+					Bold(true).
+					Foreground(lipgloss.Color("#FAFAFA")).
+					Background(lipgloss.Color("#1E3A8A")).
+					Padding(0, 1)
+			}
+
+			return lipgloss.NewStyle()
+		})
+
+	return rendered.String()
+}
+
+// WriteCSV writes the table as comma separated values, with the headers as the
+// first record.
+func (t *Table) WriteCSV(w io.Writer) error {
+	writer := csv.NewWriter(w)
+
+	if err := writer.Write(t.Headers); err != nil {
+		return err
+	}
+
+	if err := writer.WriteAll(t.Rows); err != nil {
+		return err
+	}
+
+	return writer.Error()
+}
+
+// Listing is the result of a list command, either structured objects or a table
+// depending on the output format.
+type Listing struct {
+	Objects []unstructured.Unstructured
+	Table   *Table
+}
+
+// PrintListing prints the result of a list command.
+func (f *Flags) PrintListing(w io.Writer, listing *Listing) error {
+	if f.Structured() {
+		return f.PrintList(w, listing.Objects)
+	}
+
+	if listing.Table == nil {
+		return nil
+	}
+
+	if f.CSV() {
+		return listing.Table.WriteCSV(w)
+	}
+
+	_, err := fmt.Fprintln(w, listing.Table)
+
+	return err
+}
+
+// MergeListings combines listings from several management clusters, identified
+// by a "Cluster" column for tables, or a top level "cluster" field for objects.
+func MergeListings(clusters []string, listings []*Listing) *Listing {
+	merged := &Listing{}
+
+	for i, listing := range listings {
+		if listing == nil {
+			continue
+		}
+
+		for j := range listing.Objects {
+			object := listing.Objects[j].DeepCopy()
+			object.Object["cluster"] = clusters[i]
+
+			merged.Objects = append(merged.Objects, *object)
+		}
+
+		if listing.Table == nil {
+			continue
+		}
+
+		if merged.Table == nil {
+			merged.Table = NewTable(append([]string{"Cluster"}, listing.Table.Headers...)...)
+		}
+
+		for _, row := range listing.Table.Rows {
+			merged.Table.Row(append([]string{clusters[i]}, row...)...)
+		}
+	}
+
+	return merged
+}
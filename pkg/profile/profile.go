@@ -0,0 +1,160 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package profile
+
+import (
+	goerrors "errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"github.com/nscaledev/unicli/pkg/errors"
+
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	// DefaultProfile is used when no profile has been selected.
+	DefaultProfile = "default"
+)
+
+// Profile is a named set of defaults for global and common command flags,
+// fields are named after the flags they apply to.
+type Profile struct {
+	Kubeconfig        string `json:"kubeconfig,omitempty"`
+	Context           string `json:"context,omitempty"`
+	IdentityNamespace string `json:"identity-namespace,omitempty"`
+	RegionNamespace   string `json:"region-namespace,omitempty"`
+	ComputeNamespace  string `json:"compute-namespace,omitempty"`
+	Organization      string `json:"organization,omitempty"`
+	Project           string `json:"project,omitempty"`
+}
+
+// Values returns the profile's values keyed by flag name.
+func (p *Profile) Values() map[string]string {
+	return map[string]string{
+		"kubeconfig":         p.Kubeconfig,
+		"context":            p.Context,
+		"identity-namespace": p.IdentityNamespace,
+		"region-namespace":   p.RegionNamespace,
+		"compute-namespace":  p.ComputeNamespace,
+		"organization":       p.Organization,
+		"project":            p.Project,
+	}
+}
+
+// Config is the persistent CLI configuration.
+type Config struct {
+	CurrentProfile string              `json:"current-profile,omitempty"`
+	Profiles       map[string]*Profile `json:"profiles,omitempty"`
+}
+
+// Path returns the configuration file location.
+func Path() (string, error) {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(dir, "unicli", "config.yaml"), nil
+}
+
+// Load reads the configuration, returning an empty one if none exists yet.
+func Load() (*Config, error) {
+	path, err := Path()
+	if err != nil {
+		return nil, err
+	}
+
+	config := &Config{}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if goerrors.Is(err, fs.ErrNotExist) {
+			return config, nil
+		}
+
+		return nil, err
+	}
+
+	if err := yaml.Unmarshal(data, config); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", path, err)
+	}
+
+	return config, nil
+}
+
+// Save writes the configuration.
+func (c *Config) Save() error {
+	path, err := Path()
+	if err != nil {
+		return err
+	}
+
+	data, err := yaml.Marshal(c)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, data, 0o600)
+}
+
+// ActiveName returns the name of the profile in use, this is either the
+// one explicitly requested, or the current one.
+func (c *Config) ActiveName(name string) string {
+	if name != "" {
+		return name
+	}
+
+	if c.CurrentProfile != "" {
+		return c.CurrentProfile
+	}
+
+	return DefaultProfile
+}
+
+// Active returns the profile in use, or nil if there is none.  Explicitly
+// requesting a profile that doesn't exist is an error.
+func (c *Config) Active(name string) (*Profile, error) {
+	profile, ok := c.Profiles[c.ActiveName(name)]
+	if !ok && name != "" {
+		return nil, fmt.Errorf("%w: profile %s not found", errors.ErrValidation, name)
+	}
+
+	return profile, nil
+}
+
+// Get returns the named profile, creating it if it doesn't exist.
+func (c *Config) Get(name string) *Profile {
+	if c.Profiles == nil {
+		c.Profiles = map[string]*Profile{}
+	}
+
+	profile, ok := c.Profiles[name]
+	if !ok {
+		profile = &Profile{}
+
+		c.Profiles[name] = profile
+	}
+
+	return profile
+}
@@ -0,0 +1,511 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package usage
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"maps"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/nscaledev/unicli/pkg/factory"
+	"github.com/nscaledev/unicli/pkg/flags"
+	"github.com/nscaledev/unicli/pkg/printer"
+	"github.com/nscaledev/unicli/pkg/util"
+	computev1 "github.com/unikorn-cloud/compute/pkg/apis/unikorn/v1alpha1"
+	"github.com/unikorn-cloud/core/pkg/constants"
+	kubernetesv1 "github.com/unikorn-cloud/kubernetes/pkg/apis/unikorn/v1alpha1"
+	regionv1 "github.com/unikorn-cloud/region/pkg/apis/unikorn/v1alpha1"
+	regionconstants "github.com/unikorn-cloud/region/pkg/constants"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// allColumns defines every available column name.
+var allColumns = []string{"region", "organization", "project", "instances", "nodes", "cpus", "memory", "gpus", "gpu-models", "undescribed"}
+
+// defaultColumns is the set shown when --columns is not specified.
+var defaultColumns = []string{"region", "organization", "project", "instances", "nodes", "cpus", "memory", "gpus"}
+
+type options struct {
+	UnikornFlags *factory.UnikornFlags
+	PrintFlags   *printer.Flags
+
+	organization *flags.OrganizationFlags
+	project      *flags.ProjectFlags
+	region       *flags.RegionFlags
+	columns      []string
+	contexts     *flags.ContextsFlags
+}
+
+func (o *options) AddFlags(cmd *cobra.Command, factory *factory.Factory) error {
+	if err := o.organization.AddFlags(cmd, factory, false); err != nil {
+		return err
+	}
+
+	if err := o.project.AddFlags(cmd, factory, false); err != nil {
+		return err
+	}
+
+	if err := o.region.AddFlags(cmd, factory, false); err != nil {
+		return err
+	}
+
+	cmd.Flags().StringSliceVar(&o.columns, "columns", defaultColumns,
+		fmt.Sprintf("Comma-separated list of columns to display. Available: %s", strings.Join(allColumns, ", ")))
+
+	if err := o.contexts.AddFlags(cmd, factory); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// clone returns a copy of the options that can be validated against another
+// management cluster, flags are resolved to resources specific to each cluster.
+func (o *options) clone() *options {
+	organization := o.organization.Clone()
+
+	clone := *o
+	clone.organization = organization
+	clone.project = o.project.Clone(organization)
+	clone.region = o.region.Clone()
+
+	return &clone
+}
+
+func (o *options) validateColumns(ctx context.Context, cli client.Client) error {
+	if o.PrintFlags.Wide() && slices.Equal(o.columns, defaultColumns) {
+		o.columns = allColumns
+	}
+
+	for _, col := range o.columns {
+		if !slices.Contains(allColumns, strings.ToLower(col)) {
+			return fmt.Errorf("unknown column %q, available columns: %s", col, strings.Join(allColumns, ", "))
+		}
+	}
+
+	return nil
+}
+
+func (o *options) validate(ctx context.Context, cli client.Client) error {
+	validators := []func(context.Context, client.Client) error{
+		o.organization.Validate,
+		o.project.Validate,
+		o.region.Validate,
+		o.validateColumns,
+	}
+
+	for _, validator := range validators {
+		if err := validator(ctx, cli); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func Command(factory *factory.Factory) *cobra.Command {
+	unikornFlags := &factory.UnikornFlags
+	organizationFlags := flags.NewOrganizationFlags(unikornFlags)
+
+	o := options{
+		UnikornFlags: unikornFlags,
+		PrintFlags:   &factory.PrintFlags,
+		organization: organizationFlags,
+		project:      flags.NewProjectFlags(unikornFlags, organizationFlags),
+		region:       flags.NewRegionFlags(unikornFlags),
+		contexts:     &flags.ContextsFlags{},
+	}
+
+	cmd := &cobra.Command{
+		Use:   "usage",
+		Short: "Report compute and GPU usage",
+		Long: `Report compute and GPU usage.
+
+Compute instances, and Kubernetes and virtual Kubernetes cluster workload pool
+nodes, are multiplied by the resources their flavors describe, then totalled by
+region, organization and project.  Nodes whose flavors the region doesn't
+describe are counted as undescribed, and aren't included in the resource totals.
+
+Examples:
+  # Report GPU usage for an organization as CSV
+  unicli report usage --organization acme --columns region,project,gpus,gpu-models -o csv
+
+  # Report usage across every management cluster as JSON
+  unicli report usage --all-contexts -o json`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			if err := o.contexts.Validate(cmd, factory, nil); err != nil {
+				return err
+			}
+
+			if o.contexts.Enabled() {
+				return o.contexts.Run(ctx, factory, func(ctx context.Context, cli client.Client) (*printer.Listing, error) {
+					clone := o.clone()
+
+					if err := clone.validate(ctx, cli); err != nil {
+						return nil, err
+					}
+
+					return clone.list(ctx, cli)
+				})
+			}
+
+			client, err := factory.Client(ctx)
+			if err != nil {
+				return err
+			}
+
+			if err := o.validate(ctx, client); err != nil {
+				return err
+			}
+
+			if err := o.execute(ctx, client); err != nil {
+				return err
+			}
+
+			return nil
+		},
+	}
+
+	if err := o.AddFlags(cmd, factory); err != nil {
+		panic(err)
+	}
+
+	return cmd
+}
+
+// key identifies a usage total.
+type key struct {
+	region       string
+	organization string
+	project      string
+}
+
+// usage is the resources consumed by a project in a region.
+type usage struct {
+	region       printer.Reference
+	organization printer.Reference
+	project      printer.Reference
+	instances    int
+	nodes        int
+	cpus         int
+	memory       resource.Quantity
+	gpus         map[string]int
+	undescribed  int
+}
+
+// add accumulates the resources of a number of machines of the given flavor.
+func (u *usage) add(flavor *util.Flavor, replicas int) {
+	if flavor == nil {
+		u.undescribed += replicas
+
+		return
+	}
+
+	if flavor.CPUs != nil {
+		u.cpus += *flavor.CPUs * replicas
+	}
+
+	if flavor.Memory != nil {
+		memory := flavor.Memory.DeepCopy()
+		memory.Mul(int64(replicas))
+
+		u.memory.Add(memory)
+	}
+
+	if flavor.GPU != nil {
+		u.gpus[fmt.Sprintf("%s %s", flavor.GPU.Vendor, flavor.GPU.Model)] += flavor.GPU.PhysicalCount * replicas
+	}
+}
+
+// gpuTotal returns the total number of physical GPUs.
+func (u *usage) gpuTotal() int {
+	total := 0
+
+	for _, count := range u.gpus {
+		total += count
+	}
+
+	return total
+}
+
+// gpuModels returns the GPUs by model e.g. "16x NVIDIA H100".
+func (u *usage) gpuModels() string {
+	models := make([]string, 0, len(u.gpus))
+
+	for _, model := range slices.Sorted(maps.Keys(u.gpus)) {
+		models = append(models, fmt.Sprintf("%dx %s", u.gpus[model], model))
+	}
+
+	return strings.Join(models, ", ")
+}
+
+func (u *usage) object() unstructured.Unstructured {
+	gpus := map[string]any{}
+
+	for model, count := range u.gpus {
+		gpus[model] = int64(count)
+	}
+
+	reference := func(r printer.Reference) map[string]any {
+		return map[string]any{
+			"id":   r.ID,
+			"name": r.Name,
+		}
+	}
+
+	return unstructured.Unstructured{
+		Object: map[string]any{
+			"kind":         "Usage",
+			"region":       reference(u.region),
+			"organization": reference(u.organization),
+			"project":      reference(u.project),
+			"instances":    int64(u.instances),
+			"nodes":        int64(u.nodes),
+			"cpus":         int64(u.cpus),
+			"memory":       u.memory.String(),
+			"gpus":         int64(u.gpuTotal()),
+			"gpuModels":    gpus,
+			"undescribed":  int64(u.undescribed),
+		},
+	}
+}
+
+// selector returns the labels listed resources must match.
+func (o *options) selector() labels.Selector {
+	l := labels.Set{}
+
+	if o.organization.Organization != nil {
+		l[constants.OrganizationLabel] = o.organization.Organization.Name
+	}
+
+	if o.project.Project != nil {
+		l[constants.ProjectLabel] = o.project.Project.Name
+	}
+
+	return labels.SelectorFromSet(l)
+}
+
+func (o *options) execute(ctx context.Context, cli client.Client) error {
+	listing, err := o.list(ctx, cli)
+	if err != nil {
+		return err
+	}
+
+	return o.PrintFlags.PrintListing(os.Stdout, listing)
+}
+
+func (o *options) usage(ctx context.Context, cli client.Client) ([]*usage, error) {
+	regions := &regionv1.RegionList{}
+	if err := cli.List(ctx, regions, &client.ListOptions{Namespace: o.UnikornFlags.RegionNamespace}); err != nil {
+		return nil, fmt.Errorf("failed to list regions: %w", err)
+	}
+
+	// Build region name and flavor maps (region ID -> flavor ID -> flavor)
+	regionNames := make(map[string]string)
+	flavors := make(map[string]map[string]*util.Flavor)
+
+	for i := range regions.Items {
+		region := &regions.Items[i]
+
+		regionNames[region.Name] = region.Labels[constants.NameLabel]
+		flavors[region.Name] = make(map[string]*util.Flavor)
+
+		for _, flavor := range util.RegionFlavors(region) {
+			flavors[region.Name][flavor.ID] = &flavor
+		}
+	}
+
+	orgNames, err := util.CreateOrganizationNameMap(ctx, cli, o.UnikornFlags.IdentityNamespace)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list organizations: %w", err)
+	}
+
+	projectNames, err := util.CreateProjectNameMap(ctx, cli)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list projects: %w", err)
+	}
+
+	totals := map[key]*usage{}
+
+	lookup := func(object client.Object, regionID string) *usage {
+		if o.region.Region != nil && regionID != o.region.Region.Name {
+			return nil
+		}
+
+		k := key{
+			region:       regionID,
+			organization: object.GetLabels()[constants.OrganizationLabel],
+			project:      object.GetLabels()[constants.ProjectLabel],
+		}
+
+		if _, ok := totals[k]; !ok {
+			totals[k] = &usage{
+				region:       printer.NewReference(regionNames, k.region),
+				organization: printer.NewReference(orgNames, k.organization),
+				project:      printer.NewReference(projectNames, k.project),
+				gpus:         map[string]int{},
+			}
+		}
+
+		return totals[k]
+	}
+
+	options := &client.ListOptions{
+		LabelSelector: o.selector(),
+	}
+
+	instances := &computev1.ComputeInstanceList{}
+	if err := util.ListAllNamespaces(ctx, cli, o.UnikornFlags.IdentityNamespace, o.UnikornFlags.ServiceNamespaces(), instances, options); err != nil {
+		return nil, fmt.Errorf("failed to list compute instances: %w", err)
+	}
+
+	for i := range instances.Items {
+		instance := &instances.Items[i]
+
+		regionID := instance.Labels[regionconstants.RegionLabel]
+
+		if u := lookup(instance, regionID); u != nil {
+			u.instances++
+			u.add(flavors[regionID][instance.Spec.FlavorID], 1)
+		}
+	}
+
+	clusters := &kubernetesv1.KubernetesClusterList{}
+	if err := util.ListAllNamespaces(ctx, cli, o.UnikornFlags.IdentityNamespace, o.UnikornFlags.ServiceNamespaces(), clusters, options); err != nil {
+		return nil, fmt.Errorf("failed to list clusters: %w", err)
+	}
+
+	for i := range clusters.Items {
+		cluster := &clusters.Items[i]
+
+		regionID := cluster.Spec.RegionID
+
+		if u := lookup(cluster, regionID); u != nil {
+			for _, pool := range cluster.Spec.WorkloadPools.Pools {
+				u.nodes += pool.Replicas
+				u.add(flavors[regionID][pool.FlavorID], pool.Replicas)
+			}
+		}
+	}
+
+	virtualClusters := &kubernetesv1.VirtualKubernetesClusterList{}
+	if err := util.ListAllNamespaces(ctx, cli, o.UnikornFlags.IdentityNamespace, o.UnikornFlags.ServiceNamespaces(), virtualClusters, options); err != nil {
+		return nil, fmt.Errorf("failed to list virtual clusters: %w", err)
+	}
+
+	for i := range virtualClusters.Items {
+		cluster := &virtualClusters.Items[i]
+
+		regionID := cluster.Spec.RegionID
+
+		if u := lookup(cluster, regionID); u != nil {
+			for _, pool := range cluster.Spec.WorkloadPools {
+				u.nodes += pool.Replicas
+				u.add(flavors[regionID][pool.FlavorID], pool.Replicas)
+			}
+		}
+	}
+
+	result := slices.Collect(maps.Values(totals))
+
+	slices.SortFunc(result, func(a, b *usage) int {
+		return cmp.Or(
+			cmp.Compare(a.region.Name, b.region.Name),
+			cmp.Compare(a.organization.Name, b.organization.Name),
+			cmp.Compare(a.project.Name, b.project.Name),
+		)
+	})
+
+	return result, nil
+}
+
+func (o *options) list(ctx context.Context, cli client.Client) (*printer.Listing, error) {
+	totals, err := o.usage(ctx, cli)
+	if err != nil {
+		return nil, err
+	}
+
+	if o.PrintFlags.Structured() {
+		objects := make([]unstructured.Unstructured, len(totals))
+
+		for i := range totals {
+			objects[i] = totals[i].object()
+		}
+
+		return &printer.Listing{Objects: objects}, nil
+	}
+
+	// Build headers from selected columns
+	headerMap := map[string]string{
+		"region":       "Region",
+		"organization": "Organization",
+		"project":      "Project",
+		"instances":    "Instances",
+		"nodes":        "Nodes",
+		"cpus":         "CPUs",
+		"memory":       "Memory",
+		"gpus":         "GPUs",
+		"gpu-models":   "GPU Models",
+		"undescribed":  "Undescribed",
+	}
+
+	headers := make([]string, 0, len(o.columns))
+	for _, col := range o.columns {
+		headers = append(headers, headerMap[col])
+	}
+
+	t := printer.NewTable(headers...)
+
+	for _, u := range totals {
+		// Build row values in column order
+		valueMap := map[string]string{
+			"region":       u.region.Name,
+			"organization": u.organization.Name,
+			"project":      u.project.Name,
+			"instances":    strconv.Itoa(u.instances),
+			"nodes":        strconv.Itoa(u.nodes),
+			"cpus":         strconv.Itoa(u.cpus),
+			"memory":       u.memory.String(),
+			"gpus":         strconv.Itoa(u.gpuTotal()),
+			"gpu-models":   u.gpuModels(),
+			"undescribed":  strconv.Itoa(u.undescribed),
+		}
+
+		var row []string
+		for _, col := range o.columns {
+			row = append(row, valueMap[col])
+		}
+
+		t.Row(row...)
+	}
+
+	return &printer.Listing{Table: t}, nil
+}
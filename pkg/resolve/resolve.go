@@ -0,0 +1,263 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package resolve looks up unikorn resources from an identifier a user would
+// type, either the display name or the resource ID.
+package resolve
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"strings"
+
+	"github.com/nscaledev/unicli/pkg/errors"
+	"github.com/nscaledev/unicli/pkg/util"
+	computev1 "github.com/unikorn-cloud/compute/pkg/apis/unikorn/v1alpha1"
+	"github.com/unikorn-cloud/core/pkg/constants"
+	identityv1 "github.com/unikorn-cloud/identity/pkg/apis/unikorn/v1alpha1"
+	kubernetesv1 "github.com/unikorn-cloud/kubernetes/pkg/apis/unikorn/v1alpha1"
+	regionv1 "github.com/unikorn-cloud/region/pkg/apis/unikorn/v1alpha1"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// Scope limits where resources are searched for.
+type Scope struct {
+	// IdentityNamespace is where organizations live, this is used to describe
+	// ambiguous matches, and to find organization and project namespaces when
+	// the user isn't permitted to list cluster wide.
+	IdentityNamespace string
+	// ServiceNamespaces are also searched when the user isn't permitted to list
+	// cluster wide, services keep some resources in their own namespace.
+	ServiceNamespaces []string
+	// Namespace limits the search to a single namespace, otherwise all
+	// namespaces are searched.
+	Namespace string
+	// OrganizationID limits the search to resources in an organization.
+	OrganizationID string
+	// ProjectID limits the search to resources in a project.
+	ProjectID string
+	// Labels are any other labels resources must have.
+	Labels labels.Set
+}
+
+// selector returns a label selector for the scope, and any additional labels.
+func (s *Scope) selector(extra labels.Set) labels.Selector {
+	l := labels.Set{}
+
+	maps.Copy(l, s.Labels)
+	maps.Copy(l, extra)
+
+	if s.OrganizationID != "" {
+		l[constants.OrganizationLabel] = s.OrganizationID
+	}
+
+	if s.ProjectID != "" {
+		l[constants.ProjectLabel] = s.ProjectID
+	}
+
+	return labels.SelectorFromSet(l)
+}
+
+func (s *Scope) list(ctx context.Context, cli client.Client, list client.ObjectList, options *client.ListOptions) error {
+	if s.Namespace != "" {
+		options.Namespace = s.Namespace
+
+		return cli.List(ctx, list, options)
+	}
+
+	return util.ListAllNamespaces(ctx, cli, s.IdentityNamespace, s.ServiceNamespaces, list, options)
+}
+
+// Object resolves a resource of the same type as the list's items.  The identifier
+// is first looked up as a resource ID, then as a display name.  As display names
+// are not unique, when more than one resource matches an error is returned that
+// describes each of them, so the user can choose by ID.
+func Object(ctx context.Context, cli client.Client, scope *Scope, list client.ObjectList, kind, identifier string) (client.Object, error) {
+	options := &client.ListOptions{
+		LabelSelector: scope.selector(nil),
+		FieldSelector: fields.OneTermEqualSelector("metadata.name", identifier),
+	}
+
+	if err := scope.list(ctx, cli, list, options); err != nil {
+		return nil, fmt.Errorf("failed to list %ss: %w", kind, err)
+	}
+
+	items, err := objects(list)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(items) == 1 {
+		return items[0], nil
+	}
+
+	options = &client.ListOptions{
+		LabelSelector: scope.selector(labels.Set{constants.NameLabel: identifier}),
+	}
+
+	if err := scope.list(ctx, cli, list, options); err != nil {
+		return nil, fmt.Errorf("failed to list %ss: %w", kind, err)
+	}
+
+	if items, err = objects(list); err != nil {
+		return nil, err
+	}
+
+	switch len(items) {
+	case 0:
+		return nil, fmt.Errorf("%w: unable to find %s with name or ID %s", errors.ErrValidation, kind, identifier)
+	case 1:
+		return items[0], nil
+	}
+
+	return nil, ambiguous(ctx, cli, scope, items, kind, identifier)
+}
+
+// objects returns the items in a list.
+func objects(list client.ObjectList) ([]client.Object, error) {
+	items, err := meta.ExtractList(list)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]client.Object, 0, len(items))
+
+	for _, item := range items {
+		if object, ok := item.(client.Object); ok {
+			result = append(result, object)
+		}
+	}
+
+	return result, nil
+}
+
+// ambiguous describes every resource sharing a display name, along with where
+// each lives, so the user can pick the right one.
+func ambiguous(ctx context.Context, cli client.Client, scope *Scope, items []client.Object, kind, identifier string) error {
+	// These are best effort, fall back to IDs if the user can't read them.
+	organizationNames, _ := util.CreateOrganizationNameMap(ctx, cli, scope.IdentityNamespace)
+	projectNames, _ := util.CreateProjectNameMap(ctx, cli)
+
+	lines := make([]string, len(items))
+
+	for i, item := range items {
+		var location []string
+
+		if id, ok := item.GetLabels()[constants.OrganizationLabel]; ok {
+			location = append(location, "organization "+nameOrID(organizationNames, id))
+		}
+
+		if id, ok := item.GetLabels()[constants.ProjectLabel]; ok {
+			location = append(location, "project "+nameOrID(projectNames, id))
+		}
+
+		lines[i] = "  " + item.GetName()
+
+		if len(location) > 0 {
+			lines[i] += " (" + strings.Join(location, ", ") + ")"
+		}
+	}
+
+	return fmt.Errorf("%w: %d resources of kind %s are named %s, use an ID or narrow the scope:\n%s", errors.ErrValidation, len(items), kind, identifier, strings.Join(lines, "\n"))
+}
+
+func nameOrID(names map[string]string, id string) string {
+	if name := names[id]; name != "" {
+		return name
+	}
+
+	return id
+}
+
+// typed resolves a resource and converts it to its concrete type.
+func typed[T client.Object](ctx context.Context, cli client.Client, scope *Scope, list client.ObjectList, kind, identifier string) (T, error) {
+	var zero T
+
+	object, err := Object(ctx, cli, scope, list, kind, identifier)
+	if err != nil {
+		return zero, err
+	}
+
+	resource, ok := object.(T)
+	if !ok {
+		return zero, fmt.Errorf("%w: unexpected type %T for %s", errors.ErrResource, object, kind)
+	}
+
+	return resource, nil
+}
+
+// Organization resolves an organization, these always live in the identity
+// namespace.
+func Organization(ctx context.Context, cli client.Client, scope *Scope, identifier string) (*identityv1.Organization, error) {
+	organizationScope := &Scope{
+		IdentityNamespace: scope.IdentityNamespace,
+		Namespace:         scope.IdentityNamespace,
+	}
+
+	return typed[*identityv1.Organization](ctx, cli, organizationScope, &identityv1.OrganizationList{}, "organization", identifier)
+}
+
+// Project resolves a project, these are only scoped by organization.
+func Project(ctx context.Context, cli client.Client, scope *Scope, identifier string) (*identityv1.Project, error) {
+	projectScope := &Scope{
+		IdentityNamespace: scope.IdentityNamespace,
+		OrganizationID:    scope.OrganizationID,
+	}
+
+	return typed[*identityv1.Project](ctx, cli, projectScope, &identityv1.ProjectList{}, "project", identifier)
+}
+
+func Group(ctx context.Context, cli client.Client, scope *Scope, identifier string) (*identityv1.Group, error) {
+	return typed[*identityv1.Group](ctx, cli, scope, &identityv1.GroupList{}, "group", identifier)
+}
+
+func Role(ctx context.Context, cli client.Client, scope *Scope, identifier string) (*identityv1.Role, error) {
+	return typed[*identityv1.Role](ctx, cli, scope, &identityv1.RoleList{}, "role", identifier)
+}
+
+func ClusterManager(ctx context.Context, cli client.Client, scope *Scope, identifier string) (*kubernetesv1.ClusterManager, error) {
+	return typed[*kubernetesv1.ClusterManager](ctx, cli, scope, &kubernetesv1.ClusterManagerList{}, "cluster manager", identifier)
+}
+
+func KubernetesCluster(ctx context.Context, cli client.Client, scope *Scope, identifier string) (*kubernetesv1.KubernetesCluster, error) {
+	return typed[*kubernetesv1.KubernetesCluster](ctx, cli, scope, &kubernetesv1.KubernetesClusterList{}, "kubernetes cluster", identifier)
+}
+
+func VirtualKubernetesCluster(ctx context.Context, cli client.Client, scope *Scope, identifier string) (*kubernetesv1.VirtualKubernetesCluster, error) {
+	return typed[*kubernetesv1.VirtualKubernetesCluster](ctx, cli, scope, &kubernetesv1.VirtualKubernetesClusterList{}, "virtual kubernetes cluster", identifier)
+}
+
+func ComputeInstance(ctx context.Context, cli client.Client, scope *Scope, identifier string) (*computev1.ComputeInstance, error) {
+	return typed[*computev1.ComputeInstance](ctx, cli, scope, &computev1.ComputeInstanceList{}, "compute instance", identifier)
+}
+
+func Region(ctx context.Context, cli client.Client, scope *Scope, identifier string) (*regionv1.Region, error) {
+	return typed[*regionv1.Region](ctx, cli, scope, &regionv1.RegionList{}, "region", identifier)
+}
+
+func Network(ctx context.Context, cli client.Client, scope *Scope, identifier string) (*regionv1.Network, error) {
+	return typed[*regionv1.Network](ctx, cli, scope, &regionv1.NetworkList{}, "network", identifier)
+}
+
+func SecurityGroup(ctx context.Context, cli client.Client, scope *Scope, identifier string) (*regionv1.SecurityGroup, error) {
+	return typed[*regionv1.SecurityGroup](ctx, cli, scope, &regionv1.SecurityGroupList{}, "security group", identifier)
+}
@@ -0,0 +1,214 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package virtualkubernetescluster
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/nscaledev/unicli/pkg/errors"
+	"github.com/nscaledev/unicli/pkg/factory"
+	"github.com/nscaledev/unicli/pkg/flags"
+	"github.com/nscaledev/unicli/pkg/resolve"
+	"github.com/nscaledev/unicli/pkg/util"
+	"github.com/unikorn-cloud/core/pkg/constants"
+	kubernetesv1 "github.com/unikorn-cloud/kubernetes/pkg/apis/unikorn/v1alpha1"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+type options struct {
+	UnikornFlags *factory.UnikornFlags
+
+	organization *flags.OrganizationFlags
+	project      *flags.ProjectFlags
+	edit         *flags.EditFlags
+
+	pool     string
+	replicas int
+}
+
+func (o *options) AddFlags(cmd *cobra.Command, factory *factory.Factory) error {
+	cmd.Flags().StringVar(&o.pool, "pool", "", "Workload pool name.")
+	cmd.Flags().IntVar(&o.replicas, "replicas", 0, "Number of replicas.")
+
+	for _, flag := range []string{"pool", "replicas"} {
+		if err := cmd.MarkFlagRequired(flag); err != nil {
+			return err
+		}
+	}
+
+	if err := o.organization.AddFlags(cmd, factory, false); err != nil {
+		return err
+	}
+
+	if err := o.project.AddFlags(cmd, factory, false); err != nil {
+		return err
+	}
+
+	o.edit.AddFlags(cmd)
+
+	return nil
+}
+
+func (o *options) validateReplicas(ctx context.Context, cli client.Client) error {
+	if o.replicas < 0 {
+		return fmt.Errorf("%w: replicas must be non-negative", errors.ErrValidation)
+	}
+
+	return nil
+}
+
+func (o *options) validate(ctx context.Context, cli client.Client) error {
+	validators := []func(context.Context, client.Client) error{
+		o.organization.Validate,
+		o.project.Validate,
+		o.validateReplicas,
+	}
+
+	for _, validator := range validators {
+		if err := validator(ctx, cli); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (o *options) execute(ctx context.Context, cli client.Client, identifier string) error {
+	cluster, err := resolve.VirtualKubernetesCluster(ctx, cli, flags.Scope(o.UnikornFlags, o.organization, o.project), identifier)
+	if err != nil {
+		return err
+	}
+
+	name := cluster.Labels[constants.NameLabel]
+
+	index := slices.IndexFunc(cluster.Spec.WorkloadPools, func(pool kubernetesv1.VirtualKubernetesClusterWorkloadPoolSpec) bool {
+		return pool.Name == o.pool
+	})
+
+	if index < 0 {
+		pools := make([]string, len(cluster.Spec.WorkloadPools))
+
+		for i, pool := range cluster.Spec.WorkloadPools {
+			pools[i] = pool.Name
+		}
+
+		return fmt.Errorf("%w: virtual kubernetes cluster %s has no workload pool %s, expected one of: %s", errors.ErrValidation, name, o.pool, strings.Join(pools, ", "))
+	}
+
+	pool := &cluster.Spec.WorkloadPools[index]
+
+	if pool.Replicas == o.replicas {
+		fmt.Printf("virtual kubernetes cluster %s unchanged\n", name)
+
+		return nil
+	}
+
+	changes := []string{
+		fmt.Sprintf("- workloadpool/%s replicas %d", o.pool, pool.Replicas),
+		fmt.Sprintf("+ workloadpool/%s replicas %d", o.pool, o.replicas),
+	}
+
+	if err := o.edit.Confirm("virtual kubernetes cluster", name, cluster.Name, changes); err != nil {
+		return err
+	}
+
+	region, err := util.GetRegion(ctx, cli, o.UnikornFlags.RegionNamespace, cluster.Spec.RegionID)
+	if err != nil {
+		return fmt.Errorf("failed to get region %s: %w", cluster.Spec.RegionID, err)
+	}
+
+	updated := cluster.DeepCopy()
+	updated.Spec.WorkloadPools[index].Replicas = o.replicas
+
+	revert, err := util.UpdateAllocation(ctx, cli, cluster.Namespace, cluster, util.VirtualKubernetesClusterAllocations(region, &updated.Spec))
+	if err != nil {
+		return err
+	}
+
+	if err := o.edit.Patch(ctx, cli, "virtual kubernetes cluster", name, cluster, updated); err != nil {
+		if rerr := revert(ctx); rerr != nil {
+			return fmt.Errorf("%w: %w", err, rerr)
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+func Command(factory *factory.Factory) *cobra.Command {
+	unikornFlags := &factory.UnikornFlags
+	organizationFlags := flags.NewOrganizationFlags(unikornFlags)
+	projectFlags := flags.NewProjectFlags(unikornFlags, organizationFlags)
+
+	o := options{
+		UnikornFlags: unikornFlags,
+		organization: organizationFlags,
+		project:      projectFlags,
+		edit:         flags.NewEditFlags(),
+	}
+
+	cmd := &cobra.Command{
+		Use:   "virtualkubernetescluster <name|id>",
+		Short: "Scale a virtual kubernetes cluster's workload pool",
+		Long: `Scale a virtual kubernetes cluster's workload pool.
+
+The change is shown, and must be confirmed, before it is applied.  The
+cluster's quota allocation is updated to match, so scaling up must be within
+the organization's quota.  If the cluster is modified by someone else in the
+meantime, the change is rejected and must be retried.
+
+Examples:
+  # Scale a workload pool to 5 nodes
+  unicli scale virtualkubernetescluster sandbox --organization acme --project web --pool default --replicas 5`,
+		Aliases: []string{
+			"vkc",
+		},
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: factory.VirtualKubernetesClusterNameCompletionFunc(&organizationFlags.OrganizationName, &projectFlags.ProjectName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			client, err := factory.Client(ctx)
+			if err != nil {
+				return err
+			}
+
+			if err := o.validate(ctx, client); err != nil {
+				return err
+			}
+
+			if err := o.execute(ctx, client, args[0]); err != nil {
+				return err
+			}
+
+			return nil
+		},
+	}
+
+	if err := o.AddFlags(cmd, factory); err != nil {
+		panic(err)
+	}
+
+	return cmd
+}
@@ -0,0 +1,265 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package securitygroup
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/spf13/cobra"
+
+	"github.com/nscaledev/unicli/pkg/errors"
+	"github.com/nscaledev/unicli/pkg/factory"
+	"github.com/nscaledev/unicli/pkg/flags"
+	"github.com/nscaledev/unicli/pkg/resolve"
+	"github.com/nscaledev/unicli/pkg/util"
+	"github.com/unikorn-cloud/core/pkg/constants"
+	regionv1 "github.com/unikorn-cloud/region/pkg/apis/unikorn/v1alpha1"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// editFunc applies the requested rules to the existing ones, returning the
+// updated rules, or an error if the rules cannot be applied.
+type editFunc func(existing, requested []regionv1.SecurityGroupRule) ([]regionv1.SecurityGroupRule, error)
+
+type ruleOptions struct {
+	UnikornFlags *factory.UnikornFlags
+
+	organization *flags.OrganizationFlags
+	project      *flags.ProjectFlags
+	edit         *flags.EditFlags
+	rules        flags.SecurityGroupRulesFlag
+}
+
+func (o *ruleOptions) AddFlags(cmd *cobra.Command, factory *factory.Factory) error {
+	cmd.Flags().Var(&o.rules, "rule", "Rule in the form direction=ingress,protocol=tcp,port=22,cidr=0.0.0.0/0, may be specified more than once.")
+
+	if err := cmd.MarkFlagRequired("rule"); err != nil {
+		return err
+	}
+
+	if err := o.organization.AddFlags(cmd, factory, false); err != nil {
+		return err
+	}
+
+	if err := o.project.AddFlags(cmd, factory, false); err != nil {
+		return err
+	}
+
+	o.edit.AddFlags(cmd)
+
+	return nil
+}
+
+func (o *ruleOptions) validate(ctx context.Context, cli client.Client) error {
+	validators := []func(context.Context, client.Client) error{
+		o.organization.Validate,
+		o.project.Validate,
+	}
+
+	for _, validator := range validators {
+		if err := validator(ctx, cli); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// diff describes the change in rules, added rules are prefixed with "+",
+// removed ones with "-", and unchanged ones with a space.
+func diff(existing, updated []regionv1.SecurityGroupRule) []string {
+	format := func(rules []regionv1.SecurityGroupRule) []string {
+		result := make([]string, len(rules))
+
+		for i := range rules {
+			result[i] = util.FormatSecurityGroupRule(&rules[i])
+		}
+
+		return result
+	}
+
+	before := format(existing)
+	after := format(updated)
+
+	lines := make([]string, 0, len(before)+len(after))
+
+	for _, rule := range after {
+		prefix := " "
+
+		if !slices.Contains(before, rule) {
+			prefix = "+"
+		}
+
+		lines = append(lines, fmt.Sprintf("%s rule %s", prefix, rule))
+	}
+
+	for _, rule := range before {
+		if !slices.Contains(after, rule) {
+			lines = append(lines, fmt.Sprintf("- rule %s", rule))
+		}
+	}
+
+	return lines
+}
+
+func (o *ruleOptions) execute(ctx context.Context, cli client.Client, identifier string, edit editFunc) error {
+	scope := flags.Scope(o.UnikornFlags, o.organization, o.project)
+	scope.Namespace = o.UnikornFlags.RegionNamespace
+
+	securityGroup, err := resolve.SecurityGroup(ctx, cli, scope, identifier)
+	if err != nil {
+		return err
+	}
+
+	rules, err := edit(securityGroup.Spec.Rules, o.rules)
+	if err != nil {
+		return err
+	}
+
+	name := securityGroup.Labels[constants.NameLabel]
+
+	if len(rules) == len(securityGroup.Spec.Rules) {
+		fmt.Printf("security group %s unchanged\n", name)
+
+		return nil
+	}
+
+	if err := o.edit.Confirm("security group", name, securityGroup.Name, diff(securityGroup.Spec.Rules, rules)); err != nil {
+		return err
+	}
+
+	updated := securityGroup.DeepCopy()
+	updated.Spec.Rules = rules
+
+	return o.edit.Patch(ctx, cli, "security group", name, securityGroup, updated)
+}
+
+// indexOf returns the index of the rule in the list, or -1 if not present.
+func indexOf(rules []regionv1.SecurityGroupRule, rule *regionv1.SecurityGroupRule) int {
+	formatted := util.FormatSecurityGroupRule(rule)
+
+	return slices.IndexFunc(rules, func(r regionv1.SecurityGroupRule) bool {
+		return util.FormatSecurityGroupRule(&r) == formatted
+	})
+}
+
+// addRules appends any rules not already present.
+func addRules(existing, requested []regionv1.SecurityGroupRule) ([]regionv1.SecurityGroupRule, error) {
+	result := slices.Clone(existing)
+
+	for i := range requested {
+		if indexOf(result, &requested[i]) < 0 {
+			result = append(result, requested[i])
+		}
+	}
+
+	return result, nil
+}
+
+// removeRules removes the rules, each of which must be present.
+func removeRules(existing, requested []regionv1.SecurityGroupRule) ([]regionv1.SecurityGroupRule, error) {
+	result := slices.Clone(existing)
+
+	for i := range requested {
+		index := indexOf(result, &requested[i])
+		if index < 0 {
+			return nil, fmt.Errorf("%w: security group has no rule %s", errors.ErrValidation, util.FormatSecurityGroupRule(&requested[i]))
+		}
+
+		result = slices.Delete(result, index, index+1)
+	}
+
+	return result, nil
+}
+
+func newRuleCommand(factory *factory.Factory, cmd *cobra.Command, edit editFunc) *cobra.Command {
+	unikornFlags := &factory.UnikornFlags
+	organizationFlags := flags.NewOrganizationFlags(unikornFlags)
+
+	o := ruleOptions{
+		UnikornFlags: unikornFlags,
+		organization: organizationFlags,
+		project:      flags.NewProjectFlags(unikornFlags, organizationFlags),
+		edit:         flags.NewEditFlags(),
+	}
+
+	cmd.Args = cobra.ExactArgs(1)
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		ctx := cmd.Context()
+
+		client, err := factory.Client(ctx)
+		if err != nil {
+			return err
+		}
+
+		if err := o.validate(ctx, client); err != nil {
+			return err
+		}
+
+		if err := o.execute(ctx, client, args[0], edit); err != nil {
+			return err
+		}
+
+		return nil
+	}
+
+	if err := o.AddFlags(cmd, factory); err != nil {
+		panic(err)
+	}
+
+	return cmd
+}
+
+func addRuleCommand(factory *factory.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-rule <name|id>",
+		Short: "Add rules to a security group",
+		Long: `Add rules to a security group.
+
+Rules that already exist are ignored.  The resulting rules are shown, and must
+be confirmed, before any changes are made.  If the security group is modified
+by someone else in the meantime, the change is rejected and must be retried.
+
+Examples:
+  # Allow HTTP from a private network
+  unicli securitygroup add-rule web --rule protocol=tcp,port=80,cidr=10.0.0.0/8`,
+	}
+
+	return newRuleCommand(factory, cmd, addRules)
+}
+
+func removeRuleCommand(factory *factory.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove-rule <name|id>",
+		Short: "Remove rules from a security group",
+		Long: `Remove rules from a security group.
+
+Rules must match an existing rule exactly, as shown by describe securitygroup.
+The resulting rules are shown, and must be confirmed, before any changes are
+made.  If the security group is modified by someone else in the meantime, the
+change is rejected and must be retried.
+
+Examples:
+  # Stop allowing SSH from anywhere
+  unicli securitygroup remove-rule web --rule protocol=tcp,port=22 --yes`,
+	}
+
+	return newRuleCommand(factory, cmd, removeRules)
+}
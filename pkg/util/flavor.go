@@ -0,0 +1,202 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/nscaledev/unicli/pkg/errors"
+	"github.com/unikorn-cloud/core/pkg/constants"
+	regionv1 "github.com/unikorn-cloud/region/pkg/apis/unikorn/v1alpha1"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+// Flavor is a flavor advertised by a region.  Providers describe flavors in
+// different ways, so this gives them a common form for display and filtering.
+type Flavor struct {
+	ID           string             `json:"id"`
+	Name         string             `json:"name,omitempty"`
+	Architecture string             `json:"architecture,omitempty"`
+	CPUs         *int               `json:"cpus,omitempty"`
+	CPUFamily    string             `json:"cpuFamily,omitempty"`
+	Memory       *resource.Quantity `json:"memory,omitempty"`
+	Disk         *resource.Quantity `json:"disk,omitempty"`
+	GPU          *regionv1.GPUSpec  `json:"gpu,omitempty"`
+	Baremetal    bool               `json:"baremetal,omitempty"`
+}
+
+func newFlavor(id, name string, cpu *regionv1.CPUSpec, memory, disk *resource.Quantity, gpu *regionv1.GPUSpec, baremetal bool) Flavor {
+	flavor := Flavor{
+		ID:        id,
+		Name:      name,
+		Memory:    memory,
+		Disk:      disk,
+		GPU:       gpu,
+		Baremetal: baremetal,
+	}
+
+	if cpu != nil {
+		flavor.CPUs = cpu.Count
+
+		if cpu.Architecture != nil {
+			flavor.Architecture = string(*cpu.Architecture)
+		}
+
+		if cpu.Family != nil {
+			flavor.CPUFamily = *cpu.Family
+		}
+	}
+
+	return flavor
+}
+
+// Description returns a human readable summary of the flavor e.g.
+// "8 CPUs, 64Gi, 1x NVIDIA H100", falling back to the ID if nothing is known.
+func (f *Flavor) Description() string {
+	desc := ""
+
+	if f.CPUs != nil {
+		desc += fmt.Sprintf("%d CPUs", *f.CPUs)
+	}
+
+	if f.Memory != nil {
+		if desc != "" {
+			desc += ", "
+		}
+
+		desc += f.Memory.String()
+	}
+
+	if f.GPU != nil {
+		if desc != "" {
+			desc += ", "
+		}
+
+		desc += fmt.Sprintf("%dx %s %s", f.GPU.PhysicalCount, f.GPU.Vendor, f.GPU.Model)
+	}
+
+	if desc == "" {
+		return f.ID
+	}
+
+	return desc
+}
+
+// RegionFlavors returns the flavors a region describes.  Openstack flavors are
+// only described when they are explicitly selected or have additional metadata,
+// selected flavors without metadata are returned with just their ID.
+func RegionFlavors(region *regionv1.Region) []Flavor {
+	var result []Flavor
+
+	if region.Spec.Kubernetes != nil {
+		for _, node := range region.Spec.Kubernetes.Nodes {
+			result = append(result, newFlavor(node.ID, node.Name, node.CPU, node.Memory, node.Disk, node.GPU, false))
+		}
+	}
+
+	if region.Spec.Openstack != nil && region.Spec.Openstack.Compute != nil && region.Spec.Openstack.Compute.Flavors != nil {
+		flavors := region.Spec.Openstack.Compute.Flavors
+
+		for _, metadata := range flavors.Metadata {
+			result = append(result, newFlavor(metadata.ID, "", metadata.CPU, metadata.Memory, nil, metadata.GPU, metadata.Baremetal))
+		}
+
+		if flavors.Selector != nil {
+			for _, id := range flavors.Selector.IDs {
+				if !slices.ContainsFunc(result, func(f Flavor) bool { return f.ID == id }) {
+					result = append(result, Flavor{ID: id})
+				}
+			}
+		}
+	}
+
+	return result
+}
+
+// ExportsAllFlavors returns true if the region advertises flavors it doesn't
+// describe, Openstack regions without a flavor selector export every flavor
+// the cloud has.
+func ExportsAllFlavors(region *regionv1.Region) bool {
+	if region.Spec.Provider != regionv1.ProviderOpenstack {
+		return false
+	}
+
+	if region.Spec.Openstack == nil || region.Spec.Openstack.Compute == nil || region.Spec.Openstack.Compute.Flavors == nil {
+		return true
+	}
+
+	selector := region.Spec.Openstack.Compute.Flavors.Selector
+
+	return selector == nil || len(selector.IDs) == 0
+}
+
+// ResolveFlavor resolves a flavor ID, name or description e.g. "8 CPUs, 64Gi,
+// 1x NVIDIA H100", as shown by "get flavor", to the ID of a flavor the region
+// advertises.  Regions that export all flavors don't describe them, so anything
+// not described must be given by ID.
+func ResolveFlavor(region *regionv1.Region, flavor string) (string, error) {
+	flavors := RegionFlavors(region)
+
+	var ids []string
+
+	for i := range flavors {
+		if flavors[i].ID == flavor {
+			return flavor, nil
+		}
+
+		if flavors[i].Name == flavor || flavors[i].Description() == flavor {
+			ids = append(ids, flavors[i].ID)
+		}
+	}
+
+	regionName := region.Labels[constants.NameLabel]
+
+	switch len(ids) {
+	case 1:
+		return ids[0], nil
+	case 0:
+	default:
+		return "", fmt.Errorf("%w: flavor %q is ambiguous in region %s, specify one of: %s", errors.ErrValidation, flavor, regionName, strings.Join(ids, ", "))
+	}
+
+	if ExportsAllFlavors(region) {
+		if _, err := uuid.Parse(flavor); err != nil {
+			return "", fmt.Errorf("%w: flavor %q is not described by region %s, so must be specified by ID", errors.ErrValidation, flavor, regionName)
+		}
+
+		return flavor, nil
+	}
+
+	names := make([]string, len(flavors))
+
+	for i := range flavors {
+		names[i] = flavors[i].ID
+
+		if flavors[i].Name != "" {
+			names[i] = flavors[i].Name
+		}
+	}
+
+	slices.Sort(names)
+
+	return "", fmt.Errorf("%w: flavor %q is not available in region %s, expected one of: %s", errors.ErrValidation, flavor, regionName, strings.Join(slices.Compact(names), ", "))
+}
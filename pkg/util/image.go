@@ -0,0 +1,36 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/nscaledev/unicli/pkg/errors"
+)
+
+// ValidateImage checks an image ID is well formed.  The image catalogue is held
+// by the cloud provider, not the management cluster, so images can't be looked
+// up by name, "get image" lists those known to be valid.
+func ValidateImage(image string) error {
+	if _, err := uuid.Parse(image); err != nil {
+		return fmt.Errorf("%w: image %q is not a valid image ID, see \"unicli get image\" for images in use", errors.ErrValidation, image)
+	}
+
+	return nil
+}
@@ -0,0 +1,159 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"context"
+	"slices"
+
+	"github.com/unikorn-cloud/core/pkg/constants"
+	identityv1 "github.com/unikorn-cloud/identity/pkg/apis/unikorn/v1alpha1"
+
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// ListAllNamespaces lists resources across all namespaces with a single call.
+// Users that aren't permitted to list cluster wide fall back to listing in the
+// organization and project namespaces they can see, these are narrowed down by
+// any organization or project label in the selector, and the service namespaces
+// e.g. where the region service keeps identities.  If none of these can be
+// listed either, the original error is returned.
+func ListAllNamespaces(ctx context.Context, cli client.Client, identityNamespace string, serviceNamespaces []string, list client.ObjectList, options *client.ListOptions) error {
+	if options == nil {
+		options = &client.ListOptions{}
+	}
+
+	err := cli.List(ctx, list, options)
+	if err == nil || !kerrors.IsForbidden(err) {
+		return err
+	}
+
+	namespaces, scopeErr := scopedNamespaces(ctx, cli, identityNamespace, options.LabelSelector)
+	if scopeErr != nil {
+		// The original error is more relevant to what was asked for.
+		return err
+	}
+
+	for _, namespace := range serviceNamespaces {
+		if namespace != "" && !slices.Contains(namespaces, namespace) {
+			namespaces = append(namespaces, namespace)
+		}
+	}
+
+	var items []runtime.Object
+
+	var listed int
+
+	for _, namespace := range namespaces {
+		//nolint:forcetypeassert
+		page := list.DeepCopyObject().(client.ObjectList)
+
+		namespaced := *options
+		namespaced.Namespace = namespace
+
+		if err := cli.List(ctx, page, &namespaced); err != nil {
+			if kerrors.IsForbidden(err) {
+				continue
+			}
+
+			return err
+		}
+
+		listed++
+
+		pageItems, err := meta.ExtractList(page)
+		if err != nil {
+			return err
+		}
+
+		items = append(items, pageItems...)
+	}
+
+	if listed == 0 {
+		return err
+	}
+
+	return meta.SetList(list, items)
+}
+
+// scopedNamespaces returns the organization and project namespaces that resources
+// matching the selector may live in.
+func scopedNamespaces(ctx context.Context, cli client.Client, identityNamespace string, selector labels.Selector) ([]string, error) {
+	var organizationID, projectID string
+
+	if selector != nil {
+		organizationID, _ = selector.RequiresExactMatch(constants.OrganizationLabel)
+		projectID, _ = selector.RequiresExactMatch(constants.ProjectLabel)
+	}
+
+	var organizations []identityv1.Organization
+
+	if organizationID != "" {
+		organization := &identityv1.Organization{}
+
+		if err := cli.Get(ctx, client.ObjectKey{Namespace: identityNamespace, Name: organizationID}, organization); err != nil {
+			return nil, err
+		}
+
+		organizations = append(organizations, *organization)
+	} else {
+		resources := &identityv1.OrganizationList{}
+
+		if err := cli.List(ctx, resources, &client.ListOptions{Namespace: identityNamespace}); err != nil {
+			return nil, err
+		}
+
+		organizations = resources.Items
+	}
+
+	var namespaces []string
+
+	for i := range organizations {
+		if organizations[i].Status.Namespace == "" {
+			continue
+		}
+
+		namespaces = append(namespaces, organizations[i].Status.Namespace)
+
+		projects := &identityv1.ProjectList{}
+
+		if err := cli.List(ctx, projects, &client.ListOptions{Namespace: organizations[i].Status.Namespace}); err != nil {
+			if kerrors.IsForbidden(err) {
+				continue
+			}
+
+			return nil, err
+		}
+
+		for j := range projects.Items {
+			project := &projects.Items[j]
+
+			if project.Status.Namespace == "" || (projectID != "" && project.Name != projectID) {
+				continue
+			}
+
+			namespaces = append(namespaces, project.Status.Namespace)
+		}
+	}
+
+	return namespaces, nil
+}
@@ -0,0 +1,346 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/nscaledev/unicli/pkg/errors"
+	"github.com/unikorn-cloud/core/pkg/constants"
+	coreutil "github.com/unikorn-cloud/core/pkg/util"
+	identityv1 "github.com/unikorn-cloud/identity/pkg/apis/unikorn/v1alpha1"
+	kubernetesv1 "github.com/unikorn-cloud/kubernetes/pkg/apis/unikorn/v1alpha1"
+	regionv1 "github.com/unikorn-cloud/region/pkg/apis/unikorn/v1alpha1"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// quotaCapacities returns the organization's quota for each kind of resource,
+// kinds the organization has no explicit quota for take the default.
+func quotaCapacities(ctx context.Context, cli client.Client, organizationID string) (map[string]int64, error) {
+	metadata := &identityv1.QuotaMetadataList{}
+
+	if err := cli.List(ctx, metadata); err != nil {
+		return nil, err
+	}
+
+	capacities := map[string]int64{}
+
+	for i := range metadata.Items {
+		if meta := &metadata.Items[i]; meta.Spec.Default != nil {
+			capacities[meta.Name] = meta.Spec.Default.Value()
+		}
+	}
+
+	options := &client.ListOptions{
+		LabelSelector: labels.SelectorFromSet(labels.Set{
+			constants.OrganizationLabel: organizationID,
+		}),
+	}
+
+	quotas := &identityv1.QuotaList{}
+
+	if err := cli.List(ctx, quotas, options); err != nil {
+		return nil, err
+	}
+
+	for i := range quotas.Items {
+		for _, quota := range quotas.Items[i].Spec.Quotas {
+			// Retired kinds are no longer enforced.
+			if _, ok := capacities[quota.Kind]; ok && quota.Quantity != nil {
+				capacities[quota.Kind] = quota.Quantity.Value()
+			}
+		}
+	}
+
+	return capacities, nil
+}
+
+// CheckQuota ensures that an allocation, when added to those already made in the
+// organization, doesn't exceed the organization's quota.  This is the same check
+// the identity service makes when allocations are created via the API.
+func CheckQuota(ctx context.Context, cli client.Client, organizationID string, allocation *identityv1.Allocation) error {
+	capacities, err := quotaCapacities(ctx, cli, organizationID)
+	if err != nil {
+		return err
+	}
+
+	options := &client.ListOptions{
+		LabelSelector: labels.SelectorFromSet(labels.Set{
+			constants.OrganizationLabel: organizationID,
+		}),
+	}
+
+	allocations := &identityv1.AllocationList{}
+
+	if err := cli.List(ctx, allocations, options); err != nil {
+		return err
+	}
+
+	totals := map[string]int64{}
+
+	add := func(allocation *identityv1.Allocation) {
+		for _, resource := range allocation.Spec.Allocations {
+			if resource.Committed != nil {
+				totals[resource.Kind] += resource.Committed.Value()
+			}
+
+			if resource.Reserved != nil {
+				totals[resource.Kind] += resource.Reserved.Value()
+			}
+		}
+	}
+
+	for i := range allocations.Items {
+		if allocations.Items[i].Name != allocation.Name {
+			add(&allocations.Items[i])
+		}
+	}
+
+	add(allocation)
+
+	for _, resource := range allocation.Spec.Allocations {
+		if capacity, ok := capacities[resource.Kind]; ok && totals[resource.Kind] > capacity {
+			return fmt.Errorf("%w: total allocation of %d %s would exceed quota limit of %d", errors.ErrValidation, totals[resource.Kind], resource.Kind, capacity)
+		}
+	}
+
+	return nil
+}
+
+// ResourceAllocation returns a quota allocation of a kind of resource e.g.
+// "servers".  Committed resources are always present, reserved ones may come
+// and go e.g. with autoscaling.
+func ResourceAllocation(kind string, committed, reserved int) identityv1.ResourceAllocation {
+	return identityv1.ResourceAllocation{
+		Kind:      kind,
+		Committed: resource.NewQuantity(int64(committed), resource.DecimalSI),
+		Reserved:  resource.NewQuantity(int64(reserved), resource.DecimalSI),
+	}
+}
+
+// flavorGPUs returns the number of GPUs a flavor has.  Flavors the region
+// doesn't describe have none, as far as we can tell.
+func flavorGPUs(region *regionv1.Region, id string) int {
+	flavors := RegionFlavors(region)
+
+	index := slices.IndexFunc(flavors, func(flavor Flavor) bool {
+		return flavor.ID == id
+	})
+
+	if index < 0 || flavors[index].GPU == nil {
+		return 0
+	}
+
+	return flavors[index].GPU.PhysicalCount
+}
+
+// ComputeInstanceAllocations returns the quota a compute instance consumes, as
+// calculated by the compute service.
+func ComputeInstanceAllocations(region *regionv1.Region, flavorID string) []identityv1.ResourceAllocation {
+	return []identityv1.ResourceAllocation{
+		ResourceAllocation("servers", 1, 0),
+		ResourceAllocation("gpus", flavorGPUs(region, flavorID), 0),
+	}
+}
+
+// KubernetesClusterAllocations returns the quota a kubernetes cluster consumes,
+// as calculated by the kubernetes service.  The control plane is free, and
+// autoscaling pools reserve servers above their minimum size.
+func KubernetesClusterAllocations(region *regionv1.Region, spec *kubernetesv1.KubernetesClusterSpec) []identityv1.ResourceAllocation {
+	var serversCommitted, serversReserved, gpusCommitted, gpusReserved int
+
+	for _, pool := range spec.WorkloadPools.Pools {
+		minimum := pool.Replicas
+
+		if pool.Autoscaling != nil {
+			minimum = pool.Autoscaling.MinimumReplicas
+		}
+
+		reserved := pool.Replicas - minimum
+		gpus := flavorGPUs(region, pool.FlavorID)
+
+		serversCommitted += minimum
+		serversReserved += reserved
+		gpusCommitted += minimum * gpus
+		gpusReserved += reserved * gpus
+	}
+
+	return []identityv1.ResourceAllocation{
+		ResourceAllocation("clusters", 1, 0),
+		ResourceAllocation("servers", serversCommitted, serversReserved),
+		ResourceAllocation("gpus", gpusCommitted, gpusReserved),
+	}
+}
+
+// VirtualKubernetesClusterAllocations returns the quota a virtual kubernetes
+// cluster consumes, as calculated by the kubernetes service.
+func VirtualKubernetesClusterAllocations(region *regionv1.Region, spec *kubernetesv1.VirtualKubernetesClusterSpec) []identityv1.ResourceAllocation {
+	var servers, gpus int
+
+	for _, pool := range spec.WorkloadPools {
+		servers += pool.Replicas
+		gpus += pool.Replicas * flavorGPUs(region, pool.FlavorID)
+	}
+
+	return []identityv1.ResourceAllocation{
+		ResourceAllocation("clusters", 1, 0),
+		ResourceAllocation("servers", servers, 0),
+		ResourceAllocation("gpus", gpus, 0),
+	}
+}
+
+// SetPrincipal records on whose behalf a resource was created, in the absence
+// of a user principal this is the organization and project it belongs to, just
+// as the services assume.  Services use this to release quota and for billing.
+func SetPrincipal(object client.Object, organizationID, projectID string) {
+	objectLabels := object.GetLabels()
+	if objectLabels == nil {
+		objectLabels = map[string]string{}
+	}
+
+	objectLabels[constants.OrganizationPrincipalLabel] = organizationID
+	objectLabels[constants.ProjectPrincipalLabel] = projectID
+
+	object.SetLabels(objectLabels)
+}
+
+// allocationReference returns how an allocation refers to the resource it's for,
+// by resource type and group, just as the services do.
+func allocationReference(cli client.Client, object client.Object) (string, error) {
+	gvk, err := cli.GroupVersionKindFor(object)
+	if err != nil {
+		return "", err
+	}
+
+	mapping, err := cli.RESTMapper().RESTMapping(gvk.GroupKind(), gvk.Version)
+	if err != nil {
+		return "", err
+	}
+
+	return mapping.Resource.Resource + "." + mapping.Resource.Group, nil
+}
+
+// CreateAllocation charges a resource, that is yet to be created, against its
+// organization's quota, as the services do for API requests.  Allocations live
+// in the project namespace.  The allocation is recorded on the resource, and
+// services release it when the resource is deprovisioned, they cannot do so
+// without it.
+func CreateAllocation(ctx context.Context, cli client.Client, namespace string, object client.Object, allocations []identityv1.ResourceAllocation) (*identityv1.Allocation, error) {
+	if namespace == "" {
+		return nil, fmt.Errorf("%w: project has not been provisioned", errors.ErrResource)
+	}
+
+	reference, err := allocationReference(cli, object)
+	if err != nil {
+		return nil, err
+	}
+
+	organizationID := object.GetLabels()[constants.OrganizationLabel]
+
+	allocation := &identityv1.Allocation{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: namespace,
+			Name:      coreutil.GenerateResourceID(),
+			Labels: map[string]string{
+				constants.OrganizationLabel:            organizationID,
+				constants.ProjectLabel:                 object.GetLabels()[constants.ProjectLabel],
+				constants.NameLabel:                    "undefined",
+				identityv1.ReferencedResourceKindLabel: reference,
+				identityv1.ReferencedResourceIDLabel:   object.GetName(),
+			},
+		},
+		Spec: identityv1.AllocationSpec{
+			Allocations: allocations,
+		},
+	}
+
+	if err := CheckQuota(ctx, cli, organizationID, allocation); err != nil {
+		return nil, err
+	}
+
+	if err := cli.Create(ctx, allocation); err != nil {
+		return nil, err
+	}
+
+	annotations := object.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+
+	annotations[constants.AllocationAnnotation] = allocation.Name
+
+	object.SetAnnotations(annotations)
+
+	return allocation, nil
+}
+
+// DeleteAllocation releases an allocation, for when creating the resource it
+// was for fails.
+func DeleteAllocation(ctx context.Context, cli client.Client, allocation *identityv1.Allocation) error {
+	if err := cli.Delete(ctx, allocation); client.IgnoreNotFound(err) != nil {
+		return fmt.Errorf("failed to clean up quota allocation %s: %w", allocation.Name, err)
+	}
+
+	return nil
+}
+
+// UpdateAllocation updates the allocation recorded on a resource e.g. when it's
+// scaled, ensuring it's within quota.  The function returned restores the
+// allocation, should updating the resource fail.
+func UpdateAllocation(ctx context.Context, cli client.Client, namespace string, object client.Object, allocations []identityv1.ResourceAllocation) (func(context.Context) error, error) {
+	id, ok := object.GetAnnotations()[constants.AllocationAnnotation]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s has no quota allocation", errors.ErrResource, object.GetName())
+	}
+
+	current := &identityv1.Allocation{}
+
+	if err := cli.Get(ctx, client.ObjectKey{Namespace: namespace, Name: id}, current); err != nil {
+		return nil, fmt.Errorf("failed to get quota allocation %s: %w", id, err)
+	}
+
+	updated := current.DeepCopy()
+	updated.Spec.Allocations = allocations
+
+	if err := CheckQuota(ctx, cli, object.GetLabels()[constants.OrganizationLabel], updated); err != nil {
+		return nil, err
+	}
+
+	if err := cli.Patch(ctx, updated, client.MergeFromWithOptions(current, client.MergeFromWithOptimisticLock{})); err != nil {
+		return nil, fmt.Errorf("failed to update quota allocation %s: %w", id, err)
+	}
+
+	revert := func(ctx context.Context) error {
+		reverted := updated.DeepCopy()
+		reverted.Spec = current.Spec
+
+		if err := cli.Patch(ctx, reverted, client.MergeFrom(updated)); err != nil {
+			return fmt.Errorf("failed to restore quota allocation %s: %w", id, err)
+		}
+
+		return nil
+	}
+
+	return revert, nil
+}
@@ -0,0 +1,68 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"fmt"
+	"strings"
+
+	regionv1 "github.com/unikorn-cloud/region/pkg/apis/unikorn/v1alpha1"
+)
+
+// SecurityGroupRulePorts returns the ports a rule applies to e.g. "22" or
+// "8000-8080", or "any" if unrestricted.
+func SecurityGroupRulePorts(rule *regionv1.SecurityGroupRule) string {
+	switch {
+	case rule.Port == nil:
+		return "any"
+	case rule.Port.Range != nil:
+		return fmt.Sprintf("%d-%d", rule.Port.Range.Start, rule.Port.Range.End)
+	case rule.Port.Number != nil:
+		return fmt.Sprintf("%d", *rule.Port.Number)
+	}
+
+	return "any"
+}
+
+// SecurityGroupRuleCIDR returns the prefix a rule allows traffic from or to,
+// or "any" if unrestricted.
+func SecurityGroupRuleCIDR(rule *regionv1.SecurityGroupRule) string {
+	if rule.CIDR == nil {
+		return "any"
+	}
+
+	return rule.CIDR.String()
+}
+
+// FormatSecurityGroupRule returns a human readable rule in the same form it is
+// specified on the command line e.g. "direction=ingress,protocol=tcp,port=22,cidr=0.0.0.0/0".
+func FormatSecurityGroupRule(rule *regionv1.SecurityGroupRule) string {
+	fields := []string{
+		"direction=" + string(rule.Direction),
+		"protocol=" + string(rule.Protocol),
+	}
+
+	if rule.Port != nil {
+		fields = append(fields, "port="+SecurityGroupRulePorts(rule))
+	}
+
+	if rule.CIDR != nil {
+		fields = append(fields, "cidr="+rule.CIDR.String())
+	}
+
+	return strings.Join(fields, ",")
+}
@@ -19,6 +19,7 @@ package util
 import (
 	"context"
 	"fmt"
+	"net"
 	"slices"
 
 	"github.com/nscaledev/unicli/pkg/errors"
@@ -27,246 +28,237 @@ import (
 	kubernetesv1 "github.com/unikorn-cloud/kubernetes/pkg/apis/unikorn/v1alpha1"
 	regionv1 "github.com/unikorn-cloud/region/pkg/apis/unikorn/v1alpha1"
 
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
-	"k8s.io/apimachinery/pkg/selection"
+	"k8s.io/client-go/util/retry"
 
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
-func GetOrganization(ctx context.Context, cli client.Client, namespace, organizatonName string) (*identityv1.Organization, error) {
-	requirement, err := labels.NewRequirement(constants.NameLabel, selection.Equals, []string{organizatonName})
-	if err != nil {
-		return nil, err
+// ResourceReferences returns any references other resources hold on a resource,
+// the services record these as finalizers and refuse to delete referenced
+// resources.  Finalizers used for deletion itself are ignored.
+func ResourceReferences(object client.Object) []string {
+	ignored := []string{
+		constants.Finalizer,
+		metav1.FinalizerDeleteDependents,
 	}
 
-	options := &client.ListOptions{
-		Namespace:     namespace,
-		LabelSelector: labels.NewSelector().Add(*requirement),
-	}
+	return slices.DeleteFunc(slices.Clone(object.GetFinalizers()), func(finalizer string) bool {
+		return slices.Contains(ignored, finalizer)
+	})
+}
 
-	resources := &identityv1.OrganizationList{}
+func GetRegion(ctx context.Context, cli client.Client, namespace, id string) (*regionv1.Region, error) {
+	resource := &regionv1.Region{}
 
-	if err := cli.List(ctx, resources, options); err != nil {
+	if err := cli.Get(ctx, client.ObjectKey{Namespace: namespace, Name: id}, resource); err != nil {
 		return nil, err
 	}
 
-	if len(resources.Items) != 1 {
-		return nil, fmt.Errorf("%w: unable to find organization with name %s", errors.ErrValidation, organizatonName)
-	}
-
-	if resources.Items[0].Status.Namespace == "" {
-		return nil, fmt.Errorf("%w: unable to find organization namespace", errors.ErrValidation)
-	}
-
-	return &resources.Items[0], nil
+	return resource, nil
 }
 
-func GetProject(ctx context.Context, cli client.Client, organizationID, projectName string) (*identityv1.Project, error) {
-	l := labels.Set{
-		constants.NameLabel: projectName,
-	}
+func GetOpenstackIdentity(ctx context.Context, cli client.Client, namespace, id string) (*regionv1.OpenstackIdentity, error) {
+	resource := &regionv1.OpenstackIdentity{}
 
-	if organizationID != "" {
-		l[constants.OrganizationLabel] = organizationID
+	if err := cli.Get(ctx, client.ObjectKey{Namespace: namespace, Name: id}, resource); err != nil {
+		return nil, err
 	}
 
-	options := &client.ListOptions{
-		LabelSelector: labels.SelectorFromSet(l),
-	}
+	return resource, nil
+}
 
-	resources := &identityv1.ProjectList{}
+func GetUser(ctx context.Context, cli client.Client, namespace, email string) (*identityv1.User, error) {
+	resources := &identityv1.UserList{}
 
-	if err := cli.List(ctx, resources, options); err != nil {
+	if err := cli.List(ctx, resources, &client.ListOptions{Namespace: namespace}); err != nil {
 		return nil, err
 	}
 
-	if len(resources.Items) != 1 {
-		return nil, fmt.Errorf("%w: unable to find project with name %s", errors.ErrValidation, projectName)
-	}
+	index := slices.IndexFunc(resources.Items, func(user identityv1.User) bool {
+		return user.Spec.Subject == email
+	})
 
-	if resources.Items[0].Status.Namespace == "" {
-		return nil, fmt.Errorf("%w: unable to find project namespace", errors.ErrValidation)
+	if index < 0 {
+		return nil, fmt.Errorf("%w: unable to find user with email %s", errors.ErrValidation, email)
 	}
 
-	return &resources.Items[0], nil
+	return &resources.Items[index], nil
 }
 
-func GetKubernetesCluster(ctx context.Context, cli client.Client, organizationID, projectID, clusterName string) (*kubernetesv1.KubernetesCluster, error) {
-	l := labels.Set{
-		constants.NameLabel: clusterName,
-	}
-
-	if organizationID != "" {
-		l[constants.OrganizationLabel] = organizationID
-	}
-
-	if projectID != "" {
-		l[constants.ProjectLabel] = projectID
+// GetOrganizationUser returns the membership of an organization for the user with
+// the email address.  Groups refer to organization users, not global users.
+func GetOrganizationUser(ctx context.Context, cli client.Client, identityNamespace string, organization *identityv1.Organization, email string) (*identityv1.OrganizationUser, error) {
+	user, err := GetUser(ctx, cli, identityNamespace, email)
+	if err != nil {
+		return nil, err
 	}
 
 	options := &client.ListOptions{
-		LabelSelector: labels.SelectorFromSet(l),
+		Namespace: organization.Status.Namespace,
+		LabelSelector: labels.SelectorFromSet(labels.Set{
+			constants.OrganizationLabel: organization.Name,
+			constants.UserLabel:         user.Name,
+		}),
 	}
 
-	resources := &kubernetesv1.KubernetesClusterList{}
+	resources := &identityv1.OrganizationUserList{}
 
 	if err := cli.List(ctx, resources, options); err != nil {
 		return nil, err
 	}
 
-	if len(resources.Items) != 1 {
-		return nil, fmt.Errorf("%w: unable to find kubernetes cluster with name %s", errors.ErrValidation, clusterName)
+	if len(resources.Items) == 0 {
+		return nil, fmt.Errorf("%w: user %s is not a member of organization %s", errors.ErrValidation, email, organization.Labels[constants.NameLabel])
 	}
 
 	return &resources.Items[0], nil
 }
 
-func GetVirtualKubernetesCluster(ctx context.Context, cli client.Client, organizationID, projectID, clusterName string) (*kubernetesv1.VirtualKubernetesCluster, error) {
-	l := labels.Set{
-		constants.NameLabel: clusterName,
+// IsGroupMember returns true if the organization user is a member of the group,
+// either directly, or via the subject that identifies them.
+func IsGroupMember(group *identityv1.Group, organizationUserID, email string) bool {
+	if slices.Contains(group.Spec.UserIDs, organizationUserID) {
+		return true
 	}
 
-	if organizationID != "" {
-		l[constants.OrganizationLabel] = organizationID
-	}
+	return slices.ContainsFunc(group.Spec.Subjects, func(subject identityv1.GroupSubject) bool {
+		return subject.Issuer == "" && (subject.ID == email || subject.Email == email)
+	})
+}
 
-	if projectID != "" {
-		l[constants.ProjectLabel] = projectID
+// AddGroupMember adds an organization user to the group, along with the subject
+// that identifies them, which is what tokens are checked against.
+func AddGroupMember(group *identityv1.Group, organizationUserID, email string) {
+	if !slices.Contains(group.Spec.UserIDs, organizationUserID) {
+		group.Spec.UserIDs = append(group.Spec.UserIDs, organizationUserID)
 	}
 
-	options := &client.ListOptions{
-		LabelSelector: labels.SelectorFromSet(l),
+	subject := identityv1.GroupSubject{
+		ID:    email,
+		Email: email,
 	}
 
-	resources := &kubernetesv1.VirtualKubernetesClusterList{}
-
-	if err := cli.List(ctx, resources, options); err != nil {
-		return nil, err
+	if !slices.Contains(group.Spec.Subjects, subject) {
+		group.Spec.Subjects = append(group.Spec.Subjects, subject)
 	}
+}
 
-	if len(resources.Items) != 1 {
-		return nil, fmt.Errorf("%w: unable to find virtual kubernetes cluster with name %s", errors.ErrValidation, clusterName)
-	}
+// RemoveGroupMember removes an organization user from the group, along with the
+// subject that identifies them.
+func RemoveGroupMember(group *identityv1.Group, organizationUserID, email string) {
+	group.Spec.UserIDs = slices.DeleteFunc(group.Spec.UserIDs, func(id string) bool {
+		return id == organizationUserID
+	})
 
-	return &resources.Items[0], nil
+	group.Spec.Subjects = slices.DeleteFunc(group.Spec.Subjects, func(subject identityv1.GroupSubject) bool {
+		return subject.Issuer == "" && (subject.ID == email || subject.Email == email)
+	})
 }
 
-func GetClusterManager(ctx context.Context, cli client.Client, organizationID, managerName string) (*kubernetesv1.ClusterManager, error) {
-	l := labels.Set{
-		constants.NameLabel: managerName,
-	}
+// UpdateGroup applies a change to a group with an optimistic lock.  If the group
+// is modified by someone else in the meantime, the change is applied again to
+// the latest version, so membership changes aren't lost.
+func UpdateGroup(ctx context.Context, cli client.Client, group *identityv1.Group, update func(*identityv1.Group)) error {
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		current := &identityv1.Group{}
 
-	if organizationID != "" {
-		l[constants.OrganizationLabel] = organizationID
-	}
+		if err := cli.Get(ctx, client.ObjectKeyFromObject(group), current); err != nil {
+			return err
+		}
 
-	options := &client.ListOptions{
-		LabelSelector: labels.SelectorFromSet(l),
-	}
+		updated := current.DeepCopy()
+		update(updated)
 
-	resources := &kubernetesv1.ClusterManagerList{}
+		return cli.Patch(ctx, updated, client.MergeFromWithOptions(current, client.MergeFromWithOptimisticLock{}))
+	})
+}
 
-	if err := cli.List(ctx, resources, options); err != nil {
+// CreateOrganizationNameMap creates a map of organization IDs to their display names
+func CreateOrganizationNameMap(ctx context.Context, cli client.Client, namespace string) (map[string]string, error) {
+	organizations := &identityv1.OrganizationList{}
+	if err := cli.List(ctx, organizations, &client.ListOptions{Namespace: namespace}); err != nil {
 		return nil, err
 	}
 
-	if len(resources.Items) != 1 {
-		return nil, fmt.Errorf("%w: unable to find cluster manager with name %s", errors.ErrValidation, managerName)
-	}
-
-	return &resources.Items[0], nil
-}
-
-func GetRegion(ctx context.Context, cli client.Client, namespace, id string) (*regionv1.Region, error) {
-	resource := &regionv1.Region{}
-
-	if err := cli.Get(ctx, client.ObjectKey{Namespace: namespace, Name: id}, resource); err != nil {
-		return nil, err
+	orgNames := make(map[string]string)
+	for _, org := range organizations.Items {
+		orgNames[org.Name] = org.Labels[constants.NameLabel]
 	}
 
-	return resource, nil
+	return orgNames, nil
 }
 
-func GetRegionByName(ctx context.Context, cli client.Client, namespace, regionName string) (*regionv1.Region, error) {
-	requirement, err := labels.NewRequirement(constants.NameLabel, selection.Equals, []string{regionName})
-	if err != nil {
+// CreateProjectNameMap creates a map of project IDs to their display names
+func CreateProjectNameMap(ctx context.Context, cli client.Client) (map[string]string, error) {
+	projects := &identityv1.ProjectList{}
+	if err := cli.List(ctx, projects); err != nil {
 		return nil, err
 	}
 
-	options := &client.ListOptions{
-		Namespace:     namespace,
-		LabelSelector: labels.NewSelector().Add(*requirement),
+	projectNames := make(map[string]string)
+	for _, proj := range projects.Items {
+		projectNames[proj.Name] = proj.Labels[constants.NameLabel]
 	}
 
-	resources := &regionv1.RegionList{}
+	return projectNames, nil
+}
 
-	if err := cli.List(ctx, resources, options); err != nil {
+// CreateGroupNameMap creates a map of group IDs to their display names
+func CreateGroupNameMap(ctx context.Context, cli client.Client) (map[string]string, error) {
+	groups := &identityv1.GroupList{}
+	if err := cli.List(ctx, groups); err != nil {
 		return nil, err
 	}
 
-	if len(resources.Items) != 1 {
-		return nil, fmt.Errorf("%w: unable to find region with name %s", errors.ErrValidation, regionName)
+	groupNames := make(map[string]string)
+	for _, group := range groups.Items {
+		groupNames[group.Name] = group.Labels[constants.NameLabel]
 	}
 
-	return &resources.Items[0], nil
+	return groupNames, nil
 }
 
-func GetOpenstackIdentity(ctx context.Context, cli client.Client, namespace, id string) (*regionv1.OpenstackIdentity, error) {
-	resource := &regionv1.OpenstackIdentity{}
-
-	if err := cli.Get(ctx, client.ObjectKey{Namespace: namespace, Name: id}, resource); err != nil {
+// CreateRoleNameMap creates a map of role IDs to their display names
+func CreateRoleNameMap(ctx context.Context, cli client.Client, namespace string) (map[string]string, error) {
+	roles := &identityv1.RoleList{}
+	if err := cli.List(ctx, roles, &client.ListOptions{Namespace: namespace}); err != nil {
 		return nil, err
 	}
 
-	return resource, nil
-}
-
-func GetUser(ctx context.Context, cli client.Client, namespace, email string) (*identityv1.User, error) {
-	resources := &identityv1.UserList{}
-
-	if err := cli.List(ctx, resources, &client.ListOptions{Namespace: namespace}); err != nil {
-		return nil, err
-	}
-
-	index := slices.IndexFunc(resources.Items, func(user identityv1.User) bool {
-		return user.Spec.Subject == email
-	})
-
-	if index < 0 {
-		return nil, fmt.Errorf("%w: unable to find user with email %s", errors.ErrValidation, email)
+	roleNames := make(map[string]string)
+	for _, role := range roles.Items {
+		roleNames[role.Name] = role.Labels[constants.NameLabel]
 	}
 
-	return &resources.Items[index], nil
+	return roleNames, nil
 }
 
-// CreateOrganizationNameMap creates a map of organization IDs to their display names
-func CreateOrganizationNameMap(ctx context.Context, cli client.Client, namespace string) (map[string]string, error) {
-	organizations := &identityv1.OrganizationList{}
-	if err := cli.List(ctx, organizations, &client.ListOptions{Namespace: namespace}); err != nil {
+// CreateOrganizationUserMap creates a map of organization user IDs to their email
+// addresses, limited to the namespace if not empty
+func CreateOrganizationUserMap(ctx context.Context, cli client.Client, identityNamespace, namespace string) (map[string]string, error) {
+	users := &identityv1.UserList{}
+	if err := cli.List(ctx, users, &client.ListOptions{Namespace: identityNamespace}); err != nil {
 		return nil, err
 	}
 
-	orgNames := make(map[string]string)
-	for _, org := range organizations.Items {
-		orgNames[org.Name] = org.Labels[constants.NameLabel]
+	subjects := make(map[string]string)
+	for _, user := range users.Items {
+		subjects[user.Name] = user.Spec.Subject
 	}
 
-	return orgNames, nil
-}
-
-// CreateProjectNameMap creates a map of project IDs to their display names
-func CreateProjectNameMap(ctx context.Context, cli client.Client) (map[string]string, error) {
-	projects := &identityv1.ProjectList{}
-	if err := cli.List(ctx, projects); err != nil {
+	organizationUsers := &identityv1.OrganizationUserList{}
+	if err := cli.List(ctx, organizationUsers, &client.ListOptions{Namespace: namespace}); err != nil {
 		return nil, err
 	}
 
-	projectNames := make(map[string]string)
-	for _, proj := range projects.Items {
-		projectNames[proj.Name] = proj.Labels[constants.NameLabel]
+	emails := make(map[string]string)
+	for _, organizationUser := range organizationUsers.Items {
+		emails[organizationUser.Name] = subjects[organizationUser.Labels[constants.UserLabel]]
 	}
 
-	return projectNames, nil
+	return emails, nil
 }
 
 // CreateKubernetesClusterNameMap creates a map of kubernetes cluster IDs to their display names
@@ -334,3 +326,10 @@ func CreateClusterManagerNameMap(ctx context.Context, cli client.Client, organiz
 
 	return managerNames, nil
 }
+
+// PrefixesOverlap returns true if any address is shared between the prefixes.
+// Prefixes are aligned, so they either overlap entirely or one contains the
+// network address of the other.
+func PrefixesOverlap(a, b *net.IPNet) bool {
+	return a.Contains(b.IP) || b.Contains(a.IP)
+}
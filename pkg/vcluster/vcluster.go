@@ -0,0 +1,202 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vcluster
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+
+	"github.com/nscaledev/unicli/pkg/errors"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+	"k8s.io/client-go/tools/portforward"
+	"k8s.io/client-go/transport/spdy"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// apiServerPort is the port the virtual cluster API server listens on
+	// within its pod.
+	apiServerPort = 8443
+)
+
+// ReleaseName returns the helm release name of a virtual cluster, this must
+// match the one generated by the kubernetes service.
+func ReleaseName(name string) string {
+	sum := sha256.Sum256([]byte(name))
+
+	hash := fmt.Sprintf("%x", sum)
+
+	return "vcluster-" + hash[:8]
+}
+
+// ClientConfig returns the administrator configuration for a virtual cluster,
+// as stored by the virtual cluster alongside its pod.  The server address is
+// only routable from within the cluster, see PortForward.
+func ClientConfig(ctx context.Context, cli client.Client, namespace, name string) (*clientcmdapi.Config, error) {
+	secret := &corev1.Secret{}
+
+	if err := cli.Get(ctx, client.ObjectKey{Namespace: namespace, Name: "vc-" + ReleaseName(name)}, secret); err != nil {
+		return nil, fmt.Errorf("failed to get virtual cluster configuration: %w", err)
+	}
+
+	data, ok := secret.Data["config"]
+	if !ok {
+		return nil, fmt.Errorf("%w: virtual cluster configuration missing for %s", errors.ErrResource, name)
+	}
+
+	return clientcmd.Load(data)
+}
+
+// GetPod returns the virtual cluster's running API server pod.
+func GetPod(ctx context.Context, cli client.Client, namespace, name string) (*corev1.Pod, error) {
+	options := &client.ListOptions{
+		Namespace: namespace,
+		LabelSelector: labels.SelectorFromSet(labels.Set{
+			"app":     "vcluster",
+			"release": ReleaseName(name),
+		}),
+	}
+
+	pods := &corev1.PodList{}
+
+	if err := cli.List(ctx, pods, options); err != nil {
+		return nil, fmt.Errorf("failed to list virtual cluster pods: %w", err)
+	}
+
+	for i := range pods.Items {
+		if pods.Items[i].Status.Phase == corev1.PodRunning {
+			return &pods.Items[i], nil
+		}
+	}
+
+	return nil, fmt.Errorf("%w: no running virtual cluster pod for %s in namespace %s", errors.ErrResource, name, namespace)
+}
+
+// PortForward forwards a local port to a virtual cluster's API server so it
+// can be accessed from outside of the management cluster.
+type PortForward struct {
+	// Port is the local port, valid once started.
+	Port uint16
+
+	stop chan struct{}
+	done chan error
+}
+
+// NewPortForward starts forwarding a random local port to the virtual cluster
+// pod, returning once the forward is ready to accept connections.
+func NewPortForward(ctx context.Context, config *rest.Config, pod *corev1.Pod) (*PortForward, error) {
+	clientset, err := kubernetes.NewForConfig(config)
+	if err != nil {
+		return nil, err
+	}
+
+	transport, upgrader, err := spdy.RoundTripperFor(config)
+	if err != nil {
+		return nil, err
+	}
+
+	url := clientset.CoreV1().RESTClient().Post().Resource("pods").Namespace(pod.Namespace).Name(pod.Name).SubResource("portforward").URL()
+
+	dialer := spdy.NewDialer(upgrader, &http.Client{Transport: transport}, http.MethodPost, url)
+
+	p := &PortForward{
+		stop: make(chan struct{}),
+		done: make(chan error, 1),
+	}
+
+	ready := make(chan struct{})
+
+	forwarder, err := portforward.NewOnAddresses(dialer, []string{"127.0.0.1"}, []string{fmt.Sprintf("0:%d", apiServerPort)}, p.stop, ready, io.Discard, os.Stderr)
+	if err != nil {
+		return nil, err
+	}
+
+	go func() {
+		p.done <- forwarder.ForwardPorts()
+	}()
+
+	select {
+	case <-ready:
+	case err := <-p.done:
+		return nil, fmt.Errorf("failed to forward virtual cluster port: %w", err)
+	case <-ctx.Done():
+		p.Close()
+
+		return nil, ctx.Err()
+	}
+
+	ports, err := forwarder.GetPorts()
+	if err != nil {
+		p.Close()
+
+		return nil, err
+	}
+
+	p.Port = ports[0].Local
+
+	return p, nil
+}
+
+// Done returns a channel that yields when the port forward terminates.
+func (p *PortForward) Done() <-chan error {
+	return p.done
+}
+
+// Close stops the port forward.
+func (p *PortForward) Close() {
+	select {
+	case <-p.stop:
+	default:
+		close(p.stop)
+	}
+}
+
+// ClientConfig rewrites a virtual cluster configuration to use the forwarded port.
+func (p *PortForward) ClientConfig(config *clientcmdapi.Config) *clientcmdapi.Config {
+	config = config.DeepCopy()
+
+	for _, cluster := range config.Clusters {
+		cluster.Server = fmt.Sprintf("https://localhost:%d", p.Port)
+	}
+
+	return config
+}
+
+// NewClientFunc creates a client from a configuration, so clients for other
+// clusters behave just like the management cluster's e.g. honour timeouts.
+type NewClientFunc func(*rest.Config) (client.Client, error)
+
+// Client returns a client for the virtual cluster via the port forward.
+func (p *PortForward) Client(config *clientcmdapi.Config, newClient NewClientFunc) (client.Client, error) {
+	restConfig, err := clientcmd.NewDefaultClientConfig(*p.ClientConfig(config), nil).ClientConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	return newClient(restConfig)
+}
@@ -0,0 +1,475 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wait
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/nscaledev/unicli/pkg/errors"
+	"github.com/nscaledev/unicli/pkg/factory"
+	"github.com/nscaledev/unicli/pkg/flags"
+	"github.com/nscaledev/unicli/pkg/resolve"
+	"github.com/nscaledev/unicli/pkg/util"
+	computev1 "github.com/unikorn-cloud/compute/pkg/apis/unikorn/v1alpha1"
+	unikornv1core "github.com/unikorn-cloud/core/pkg/apis/unikorn/v1alpha1"
+	identityv1 "github.com/unikorn-cloud/identity/pkg/apis/unikorn/v1alpha1"
+	kubernetesv1 "github.com/unikorn-cloud/kubernetes/pkg/apis/unikorn/v1alpha1"
+	regionv1 "github.com/unikorn-cloud/region/pkg/apis/unikorn/v1alpha1"
+
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// kind describes a resource type that can be waited on.
+type kind struct {
+	// names are what the kind can be referred to as, the first is used
+	// for display purposes.
+	names []string
+	// list returns an empty list of the kind.
+	list func() client.ObjectList
+	// namespace returns the namespace resources live in, if all resources
+	// are in a single namespace.
+	namespace func(*factory.UnikornFlags) string
+	// scope is how the kind is scoped by organization and project labels.
+	scope scope
+	// resolve, if set, replaces resolution by name or ID, for kinds that
+	// are identified by something else.
+	resolve func(context.Context, client.Client, *factory.UnikornFlags, string) (client.Object, error)
+}
+
+// scope defines which labels can be used to filter a kind.
+type scope int
+
+const (
+	// scopeGlobal kinds are not owned by an organization.
+	scopeGlobal scope = iota
+	// scopeOrganization kinds have an organization label.
+	scopeOrganization
+	// scopeProject kinds have both organization and project labels.
+	scopeProject
+)
+
+//nolint:gochecknoglobals
+var kinds = []kind{
+	{
+		names: []string{"organization", "org"},
+		list:  func() client.ObjectList { return &identityv1.OrganizationList{} },
+		namespace: func(f *factory.UnikornFlags) string {
+			return f.IdentityNamespace
+		},
+	},
+	{
+		names: []string{"project"},
+		scope: scopeOrganization,
+		list:  func() client.ObjectList { return &identityv1.ProjectList{} },
+	},
+	{
+		names: []string{"group"},
+		scope: scopeOrganization,
+		list:  func() client.ObjectList { return &identityv1.GroupList{} },
+	},
+	{
+		names: []string{"clustermanager", "cm"},
+		scope: scopeProject,
+		list:  func() client.ObjectList { return &kubernetesv1.ClusterManagerList{} },
+	},
+	{
+		names: []string{"kubernetescluster", "kc"},
+		scope: scopeProject,
+		list:  func() client.ObjectList { return &kubernetesv1.KubernetesClusterList{} },
+	},
+	{
+		names: []string{"virtualkubernetescluster", "vkc"},
+		scope: scopeProject,
+		list:  func() client.ObjectList { return &kubernetesv1.VirtualKubernetesClusterList{} },
+	},
+	{
+		names: []string{"instance", "computeinstance", "ci"},
+		scope: scopeProject,
+		list:  func() client.ObjectList { return &computev1.ComputeInstanceList{} },
+		namespace: func(f *factory.UnikornFlags) string {
+			return f.ComputeNamespace
+		},
+	},
+	{
+		names: []string{"network"},
+		scope: scopeProject,
+		list:  func() client.ObjectList { return &regionv1.NetworkList{} },
+		namespace: func(f *factory.UnikornFlags) string {
+			return f.RegionNamespace
+		},
+	},
+	{
+		names: []string{"securitygroup", "sg"},
+		scope: scopeProject,
+		list:  func() client.ObjectList { return &regionv1.SecurityGroupList{} },
+		namespace: func(f *factory.UnikornFlags) string {
+			return f.RegionNamespace
+		},
+	},
+	{
+		names: []string{"openstackidentity"},
+		scope: scopeProject,
+		list:  func() client.ObjectList { return &regionv1.OpenstackIdentityList{} },
+		namespace: func(f *factory.UnikornFlags) string {
+			return f.RegionNamespace
+		},
+	},
+	{
+		names: []string{"region"},
+		list:  func() client.ObjectList { return &regionv1.RegionList{} },
+		namespace: func(f *factory.UnikornFlags) string {
+			return f.RegionNamespace
+		},
+	},
+	{
+		names: []string{"role"},
+		list:  func() client.ObjectList { return &identityv1.RoleList{} },
+		namespace: func(f *factory.UnikornFlags) string {
+			return f.IdentityNamespace
+		},
+	},
+	{
+		names: []string{"user"},
+		list:  func() client.ObjectList { return &identityv1.UserList{} },
+		namespace: func(f *factory.UnikornFlags) string {
+			return f.IdentityNamespace
+		},
+		resolve: resolveUser,
+	},
+}
+
+// resolveUser finds a user by ID, or by email as users are known elsewhere.
+func resolveUser(ctx context.Context, cli client.Client, f *factory.UnikornFlags, identifier string) (client.Object, error) {
+	user := &identityv1.User{}
+
+	if err := cli.Get(ctx, client.ObjectKey{Namespace: f.IdentityNamespace, Name: identifier}, user); err == nil {
+		return user, nil
+	} else if !kerrors.IsNotFound(err) {
+		return nil, err
+	}
+
+	return util.GetUser(ctx, cli, f.IdentityNamespace, identifier)
+}
+
+func kindNames() []string {
+	names := make([]string, len(kinds))
+
+	for i := range kinds {
+		names[i] = kinds[i].names[0]
+	}
+
+	return names
+}
+
+func lookupKind(name string) (*kind, error) {
+	for i := range kinds {
+		if slices.Contains(kinds[i].names, strings.ToLower(name)) {
+			return &kinds[i], nil
+		}
+	}
+
+	return nil, fmt.Errorf("%w: unsupported kind %s, expected one of: %s", errors.ErrValidation, name, strings.Join(kindNames(), ", "))
+}
+
+type options struct {
+	UnikornFlags *factory.UnikornFlags
+
+	organization *flags.OrganizationFlags
+	project      *flags.ProjectFlags
+	condition    string
+	timeout      time.Duration
+
+	// deleted is true if waiting for deletion, otherwise conditionType
+	// and conditionStatus are what's being waited for.
+	deleted         bool
+	conditionType   unikornv1core.ConditionType
+	conditionStatus corev1.ConditionStatus
+}
+
+func (o *options) AddFlags(cmd *cobra.Command, factory *factory.Factory) error {
+	if err := o.organization.AddFlags(cmd, factory, false); err != nil {
+		return err
+	}
+
+	if err := o.project.AddFlags(cmd, factory, false); err != nil {
+		return err
+	}
+
+	cmd.Flags().StringVar(&o.condition, "for", "", "The condition to wait for, either condition=<type>[=<status>] or deleted.")
+	cmd.Flags().DurationVar(&o.timeout, "timeout", 10*time.Minute, "How long to wait for the condition.")
+
+	if err := cmd.MarkFlagRequired("for"); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (o *options) validateCondition(_ context.Context, _ client.Client) error {
+	if o.condition == "delete" || o.condition == "deleted" {
+		o.deleted = true
+
+		return nil
+	}
+
+	condition, ok := strings.CutPrefix(o.condition, "condition=")
+	if !ok || condition == "" {
+		return fmt.Errorf("%w: --for must be condition=<type>[=<status>] or deleted", errors.ErrValidation)
+	}
+
+	conditionType, conditionStatus, ok := strings.Cut(condition, "=")
+	if !ok {
+		conditionStatus = string(corev1.ConditionTrue)
+	}
+
+	o.conditionType = unikornv1core.ConditionType(conditionType)
+	o.conditionStatus = corev1.ConditionStatus(conditionStatus)
+
+	return nil
+}
+
+func (o *options) validate(ctx context.Context, cli client.Client) error {
+	validators := []func(context.Context, client.Client) error{
+		o.organization.Validate,
+		o.project.Validate,
+		o.validateCondition,
+	}
+
+	for _, validator := range validators {
+		if err := validator(ctx, cli); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// resolve finds the resource to wait on.
+func (o *options) resolve(ctx context.Context, cli client.Client, k *kind, identifier string) (client.Object, error) {
+	if k.resolve != nil {
+		return k.resolve(ctx, cli, o.UnikornFlags, identifier)
+	}
+
+	scope := &resolve.Scope{
+		IdentityNamespace: o.UnikornFlags.IdentityNamespace,
+		ServiceNamespaces: o.UnikornFlags.ServiceNamespaces(),
+	}
+
+	if k.scope >= scopeOrganization && o.organization.Organization != nil {
+		scope.OrganizationID = o.organization.Organization.Name
+	}
+
+	if k.scope >= scopeProject && o.project.Project != nil {
+		scope.ProjectID = o.project.Project.Name
+	}
+
+	if k.namespace != nil {
+		scope.Namespace = k.namespace(o.UnikornFlags)
+	}
+
+	return resolve.Object(ctx, cli, scope, k.list(), k.names[0], identifier)
+}
+
+// checkDeleted returns true if the resource has gone, along with a description
+// of its current state.
+func (o *options) checkDeleted(ctx context.Context, cli client.Client, resource client.Object) (bool, string, error) {
+	if err := cli.Get(ctx, client.ObjectKeyFromObject(resource), resource); err != nil {
+		if kerrors.IsNotFound(err) {
+			return true, "deleted", nil
+		}
+
+		return false, "", err
+	}
+
+	if resource.GetDeletionTimestamp() == nil {
+		return false, "exists", nil
+	}
+
+	return false, "deleting, waiting for finalizers " + strings.Join(resource.GetFinalizers(), ", "), nil
+}
+
+// checkCondition returns true if the resource's condition has the required status,
+// along with a description of the condition.  A resource that has errored is
+// considered to have failed.
+func (o *options) checkCondition(ctx context.Context, cli client.Client, resource client.Object) (bool, string, error) {
+	reader, ok := resource.(flags.ConditionResource)
+	if !ok {
+		return false, "", fmt.Errorf("%w: resource does not report conditions, only deleted is supported", errors.ErrValidation)
+	}
+
+	if err := cli.Get(ctx, client.ObjectKeyFromObject(resource), resource); err != nil {
+		if kerrors.IsNotFound(err) {
+			return false, "", fmt.Errorf("%w: resource was deleted", errors.ErrFailed)
+		}
+
+		return false, "", err
+	}
+
+	condition, err := reader.StatusConditionRead(o.conditionType)
+	if err != nil {
+		//nolint:nilerr
+		return false, fmt.Sprintf("condition %s not yet reported", o.conditionType), nil
+	}
+
+	status := fmt.Sprintf("%s=%s %s", condition.Type, condition.Status, condition.Reason)
+
+	if condition.Message != "" {
+		status += ": " + condition.Message
+	}
+
+	if condition.Reason == unikornv1core.ConditionReasonErrored {
+		return false, status, fmt.Errorf("%w: %s", errors.ErrFailed, status)
+	}
+
+	return condition.Status == o.conditionStatus, status, nil
+}
+
+// stopped explains why waiting stopped before the condition was met, either
+// the command was interrupted, or the timeout expired.
+func (o *options) stopped(ctx context.Context, k *kind, identifier, last string) error {
+	if err := ctx.Err(); err != nil {
+		return fmt.Errorf("%w: waiting for %s %s: %w", errors.ErrAborted, k.names[0], identifier, err)
+	}
+
+	return fmt.Errorf("%w: waiting for %s %s after %v, last status %s", errors.ErrTimeout, k.names[0], identifier, o.timeout, last)
+}
+
+func (o *options) execute(ctx context.Context, cli client.Client, kindName, identifier string) error {
+	k, err := lookupKind(kindName)
+	if err != nil {
+		return err
+	}
+
+	resource, err := o.resolve(ctx, cli, k, identifier)
+	if err != nil {
+		return err
+	}
+
+	check := o.checkCondition
+
+	if o.deleted {
+		check = o.checkDeleted
+	}
+
+	// Individual API calls are bounded by the request timeout, waiting as
+	// a whole by our own.
+	waitCtx, cancel := context.WithTimeout(ctx, o.timeout)
+	defer cancel()
+
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+
+	var last string
+
+	for {
+		done, status, err := check(waitCtx, cli, resource)
+		if err != nil {
+			if waitCtx.Err() != nil {
+				return o.stopped(ctx, k, identifier, last)
+			}
+
+			return fmt.Errorf("%s %s: %w", k.names[0], identifier, err)
+		}
+
+		if status != last {
+			fmt.Printf("%s %s: %s\n", k.names[0], identifier, status)
+
+			last = status
+		}
+
+		if done {
+			return nil
+		}
+
+		select {
+		case <-waitCtx.Done():
+			return o.stopped(ctx, k, identifier, last)
+		case <-ticker.C:
+		}
+	}
+}
+
+func Command(factory *factory.Factory) *cobra.Command {
+	unikornFlags := &factory.UnikornFlags
+	organizationFlags := flags.NewOrganizationFlags(unikornFlags)
+
+	o := options{
+		UnikornFlags: unikornFlags,
+		organization: organizationFlags,
+		project:      flags.NewProjectFlags(unikornFlags, organizationFlags),
+	}
+
+	cmd := &cobra.Command{
+		Use:   "wait <kind> <name|id>",
+		Short: "Wait for a resource to reach a condition",
+		Long: fmt.Sprintf(`Wait for a resource to reach a condition.
+
+Supported kinds: %s.
+
+The command exits with status 0 when the condition is met, 2 if the timeout
+expires, 3 if the resource has failed e.g. has errored or been deleted, 4 if
+an API call exceeds --request-timeout, and 1 for any other error.
+
+Examples:
+  # Wait for a kubernetes cluster to be provisioned
+  unicli wait kubernetescluster my-cluster --for=condition=Available
+
+  # Wait for an instance to be deleted
+  unicli wait instance my-instance --for=deleted --timeout=5m`, strings.Join(kindNames(), ", ")),
+		Args: cobra.ExactArgs(2),
+		ValidArgsFunction: func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+			if len(args) == 0 {
+				return kindNames(), cobra.ShellCompDirectiveNoFileComp
+			}
+
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			client, err := factory.Client(ctx)
+			if err != nil {
+				return err
+			}
+
+			if err := o.validate(ctx, client); err != nil {
+				return err
+			}
+
+			if err := o.execute(ctx, client, args[0], args[1]); err != nil {
+				return err
+			}
+
+			return nil
+		},
+	}
+
+	if err := o.AddFlags(cmd, factory); err != nil {
+		panic(err)
+	}
+
+	return cmd
+}